@@ -0,0 +1,59 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// AbuseReport - a spam complaint made by a member of a list
+type AbuseReport struct {
+	ID           int                    `json:"id"`
+	CampaignID   string                 `json:"campaign_id"` // The campaign the complaint was made against.
+	ListID       string                 `json:"list_id"`
+	EmailID      string                 `json:"email_id"` // The MD5 hash of the lowercase version of the member's email address.
+	EmailAddress string                 `json:"email_address"`
+	MergeFields  map[string]interface{} `json:"merge_fields"`
+	VIP          bool                   `json:"vip"`
+	Date         string                 `json:"date"` // The date and time the complaint was made.
+}
+
+// ListAbuseReportsResponse ...
+type ListAbuseReportsResponse struct {
+	AbuseReports []AbuseReport `json:"abuse_reports"`
+	ListID       string        `json:"list_id"`
+	TotalItems   int           `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// ListAbuseReports returns a page of the abuse reports of a list
+func (c *Client) ListAbuseReports(listID string, params *PaginationParams) (*ListAbuseReportsResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+	}
+	listAbuseReportsResponse := new(ListAbuseReportsResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/lists/%s/abuse-reports", listID), query),
+		nil,
+		listAbuseReportsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listAbuseReportsResponse, nil
+}
+
+// GetAbuseReport returns a specific abuse report of a list
+func (c *Client) GetAbuseReport(listID string, reportID int) (*AbuseReport, error) {
+	abuseReport := new(AbuseReport)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/abuse-reports/%d", listID, reportID),
+		nil,
+		abuseReport,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return abuseReport, nil
+}
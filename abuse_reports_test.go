@@ -0,0 +1,45 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAbuseReports(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/abuse-reports", req.URL.Path)
+		assert.Equal(t, "count=100&offset=200", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"abuse_reports": [%s], "list_id": "list_id", "total_items": 201}`, abuseReportResponse)
+	})
+	defer server.Close()
+
+	listAbuseReportsResponse, err := client.ListAbuseReports("list_id", &mailchimp.PaginationParams{Count: 100, Offset: 200})
+	assert.NoError(t, err)
+	assert.Equal(t, 201, listAbuseReportsResponse.TotalItems)
+	report := listAbuseReportsResponse.AbuseReports[0]
+	assert.Equal(t, "john@reese.com", report.EmailAddress)
+	assert.Equal(t, "42694e9e57", report.CampaignID)
+}
+
+func TestGetAbuseReport(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/abuse-reports/8841", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, abuseReportResponse)
+	})
+	defer server.Close()
+
+	report, err := client.GetAbuseReport("list_id", 8841)
+	assert.NoError(t, err)
+	assert.Equal(t, 8841, report.ID)
+	assert.Equal(t, "John", report.MergeFields["FNAME"])
+}
@@ -0,0 +1,96 @@
+package mailchimp
+
+import (
+	"fmt"
+
+	"github.com/RichardKnop/go-mailchimp/status"
+)
+
+// AddOrUpdateMemberParams - see https://developer.mailchimp.com/documentation/mailchimp/reference/lists/members/#edit-put_lists_list_id_members_subscriber_hash
+type AddOrUpdateMemberParams struct {
+	StatusIfNew status.MemberStatus    `json:"status_if_new"`        // Status used if the member is new. Defaults to subscribed.
+	Status      status.MemberStatus    `json:"status,omitempty"`     // Status of an existing member. Left unchanged if empty.
+	EmailType   string                 `json:"email_type,omitempty"` // Type of email this member asked to get ('html' or 'text').
+	MergeFields map[string]interface{} `json:"merge_fields,omitempty"`
+	Interests   map[string]bool        `json:"interests,omitempty"` // The key of this object's properties is the ID of the interest in question.
+	Language    string                 `json:"language,omitempty"`  // If set/detected, the subscriber's language.
+	VIP         *bool                  `json:"vip,omitempty"`
+	Location    *MemberLocation        `json:"location,omitempty"`
+
+	MarketingPermissions []MarketingPermission `json:"marketing_permissions,omitempty"`
+
+	IPSignup        string `json:"ip_signup,omitempty"`        // IP address the subscriber signed up from.
+	TimestampSignup string `json:"timestamp_signup,omitempty"` // Date and time the subscriber signed up for the list in ISO 8601 format.
+	IPOpt           string `json:"ip_opt,omitempty"`           // IP address the subscriber confirmed their opt-in status.
+	TimestampOpt    string `json:"timestamp_opt,omitempty"`    // Date and time the subscriber confirmed their opt-in status in ISO 8601 format.
+}
+
+type addOrUpdateMemberRequest struct {
+	EmailAddress string `json:"email_address"`
+	AddOrUpdateMemberParams
+}
+
+// AddOrUpdateMember adds a new list member or updates the existing one (upsert),
+// so it is safe to call repeatedly for the same email
+func (c *Client) AddOrUpdateMember(listID string, email string, params *AddOrUpdateMemberParams) (*MemberResponse, error) {
+	email, err := c.validateEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	body := &addOrUpdateMemberRequest{EmailAddress: email}
+	if params != nil {
+		body.AddOrUpdateMemberParams = *params
+	}
+	if body.StatusIfNew == "" {
+		body.StatusIfNew = status.Subscribed
+	}
+	memberResponse := new(MemberResponse)
+	err = c.request(
+		"PUT",
+		fmt.Sprintf("/lists/%s/members/%s", listID, SubscriberHash(email)),
+		body,
+		memberResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return memberResponse, nil
+}
+
+// ChangeMemberEmail changes the email address of an existing list member,
+// keeping their subscription and engagement history. The member is looked up
+// first, so the API error is returned rather than a new member being created
+// when email is not on the list.
+func (c *Client) ChangeMemberEmail(listID string, email string, newEmail string) (*MemberResponse, error) {
+	existingMember, err := c.GetMember(listID, email)
+	if err != nil {
+		return nil, err
+	}
+	body := &addOrUpdateMemberRequest{
+		EmailAddress:            newEmail,
+		AddOrUpdateMemberParams: AddOrUpdateMemberParams{StatusIfNew: statusIfNew(existingMember.Status)},
+	}
+	memberResponse := new(MemberResponse)
+	err = c.request(
+		"PUT",
+		fmt.Sprintf("/lists/%s/members/%s", listID, SubscriberHash(email)),
+		body,
+		memberResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return memberResponse, nil
+}
+
+// statusIfNew returns memberStatus if the API accepts it as status_if_new.
+// Other statuses, e.g. archived, fall back to subscribed; the value is only
+// applied if the member is created, which the lookup in ChangeMemberEmail
+// rules out.
+func statusIfNew(memberStatus status.MemberStatus) status.MemberStatus {
+	switch memberStatus {
+	case status.Subscribed, status.Unsubscribed, status.Cleaned, status.Pending, status.Transactional:
+		return memberStatus
+	}
+	return status.Subscribed
+}
@@ -0,0 +1,111 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/RichardKnop/go-mailchimp/status"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddOrUpdateMember(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"email_address": "john@reese.com",
+			"status_if_new": string(status.Subscribed),
+			"merge_fields":  map[string]interface{}{"FNAME": "John"},
+		}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, successResponse)
+	})
+	defer server.Close()
+
+	memberResponse, err := client.AddOrUpdateMember("list_id", "john@reese.com", &mailchimp.AddOrUpdateMemberParams{
+		MergeFields: map[string]interface{}{"FNAME": "John"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "john@reese.com", memberResponse.EmailAddress)
+	assert.Equal(t, status.Subscribed, memberResponse.Status)
+}
+
+func TestChangeMemberEmail(t *testing.T) {
+	var methods []string
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		methods = append(methods, req.Method)
+		// The member is looked up by the hash of the old email
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769", req.URL.Path)
+
+		if req.Method == "GET" {
+			rw.WriteHeader(200)
+			fmt.Fprint(rw, `{"id": "a12bef585f1cae41a46e7edd45ade769", "email_address": "john@reese.com", "status": "unsubscribed"}`)
+			return
+		}
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, "john@samaritan.com", params["email_address"])
+		// A member recreated by the upsert keeps the existing status
+		assert.Equal(t, string(status.Unsubscribed), params["status_if_new"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"id": "9a3ba8c02c21e2620c5da0d3fdd4bc1f", "email_address": "john@samaritan.com", "status": "unsubscribed"}`)
+	})
+	defer server.Close()
+
+	memberResponse, err := client.ChangeMemberEmail("list_id", "john@reese.com", "john@samaritan.com")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"GET", "PUT"}, methods)
+	assert.Equal(t, "john@samaritan.com", memberResponse.EmailAddress)
+}
+
+func TestChangeMemberEmailArchived(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == "GET" {
+			rw.WriteHeader(200)
+			fmt.Fprint(rw, `{"id": "a12bef585f1cae41a46e7edd45ade769", "email_address": "john@reese.com", "status": "archived"}`)
+			return
+		}
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		// Archived is not accepted as status_if_new
+		assert.Equal(t, map[string]interface{}{
+			"email_address": "john@samaritan.com",
+			"status_if_new": string(status.Subscribed),
+		}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"id": "9a3ba8c02c21e2620c5da0d3fdd4bc1f", "email_address": "john@samaritan.com", "status": "archived"}`)
+	})
+	defer server.Close()
+
+	memberResponse, err := client.ChangeMemberEmail("list_id", "john@reese.com", "john@samaritan.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "john@samaritan.com", memberResponse.EmailAddress)
+}
+
+func TestChangeMemberEmailNotFound(t *testing.T) {
+	var methods []string
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		methods = append(methods, req.Method)
+
+		rw.WriteHeader(404)
+		fmt.Fprint(rw, notFoundErrorResponse)
+	})
+	defer server.Close()
+
+	memberResponse, err := client.ChangeMemberEmail("list_id", "john@reese.com", "john@samaritan.com")
+	assert.Nil(t, memberResponse)
+	assert.Equal(t, "Error 404 Resource Not Found (The requested resource could not be found.)", err.Error())
+	// Nothing is created for a missing member
+	assert.Equal(t, []string{"GET"}, methods)
+}
@@ -0,0 +1,164 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// AutomationStatus - the status of a classic automation workflow
+type AutomationStatus string
+
+// Automation statuses
+const (
+	AutomationSave    AutomationStatus = "save" // A draft that has never been started.
+	AutomationPaused  AutomationStatus = "paused"
+	AutomationSending AutomationStatus = "sending"
+)
+
+// Automation workflow types, see AutomationTriggerSettings
+const (
+	AutomationAbandonedBrowse  = "abandonedBrowse"
+	AutomationAbandonedCart    = "abandonedCart"
+	AutomationAPI              = "api"
+	AutomationDateAdded        = "dateAdded"
+	AutomationEmailFollowup    = "emailFollowup"
+	AutomationEmailSeries      = "emailSeries"
+	AutomationFirstPurchase    = "firstPurchase"
+	AutomationGroupAdd         = "groupAdd"
+	AutomationGroupRemove      = "groupRemove"
+	AutomationPurchaseFollowup = "purchaseFollowup"
+	AutomationRecurringAction  = "recurringAction"
+	AutomationSpecialEvent     = "specialEvent"
+	AutomationVisitURL         = "visitUrl"
+	AutomationWelcomeSeries    = "welcomeSeries"
+)
+
+// AutomationSendBetween - the window during the day in which emails are sent
+type AutomationSendBetween struct {
+	Start string `json:"start"` // E.g. 09:00.
+	End   string `json:"end"`
+}
+
+// AutomationRuntimeHours - the hours during which emails are sent
+type AutomationRuntimeHours struct {
+	Type        string                 `json:"type"` // One of send_asap, send_between or send_at.
+	SendAt      string                 `json:"send_at,omitempty"`
+	SendBetween *AutomationSendBetween `json:"send_between,omitempty"`
+}
+
+// AutomationRuntime - when the emails of an automation are sent
+type AutomationRuntime struct {
+	Days  []string               `json:"days"` // The days of the week emails are sent, e.g. monday.
+	Hours AutomationRuntimeHours `json:"hours"`
+}
+
+// AutomationTriggerSettings - what starts an automation workflow for a subscriber
+type AutomationTriggerSettings struct {
+	WorkflowType        string            `json:"workflow_type"` // One of the Automation workflow type constants.
+	WorkflowTitle       string            `json:"workflow_title"`
+	Runtime             AutomationRuntime `json:"runtime"`
+	WorkflowEmailsCount int               `json:"workflow_emails_count"` // The number of emails in the workflow.
+}
+
+// AutomationRecipients - the list and segment an automation sends to
+type AutomationRecipients struct {
+	ListID       string                  `json:"list_id"`
+	ListIsActive bool                    `json:"list_is_active"`
+	ListName     string                  `json:"list_name"`
+	SegmentOpts  *CampaignSegmentOptions `json:"segment_opts,omitempty"`
+	StoreID      string                  `json:"store_id"` // The store ID of ecommerce automations.
+}
+
+// AutomationSettings - the settings shared by the emails of an automation
+type AutomationSettings struct {
+	Title           string `json:"title"`
+	FromName        string `json:"from_name"`
+	ReplyTo         string `json:"reply_to"`
+	UseConversation bool   `json:"use_conversation"` // Whether replies are managed in the Mailchimp Conversations feature.
+	ToName          string `json:"to_name"`
+	Authenticate    bool   `json:"authenticate"`
+	AutoFooter      bool   `json:"auto_footer"`
+	InlineCSS       bool   `json:"inline_css"`
+}
+
+// AutomationReportSummary - the stats of all the emails of an automation
+type AutomationReportSummary struct {
+	Opens            int     `json:"opens"`
+	UniqueOpens      int     `json:"unique_opens"`
+	OpenRate         float64 `json:"open_rate"`
+	Clicks           int     `json:"clicks"`
+	SubscriberClicks int     `json:"subscriber_clicks"` // The number of subscribers who clicked at least once.
+	ClickRate        float64 `json:"click_rate"`
+}
+
+// Automation - see https://developer.mailchimp.com/documentation/mailchimp/reference/automations/
+type Automation struct {
+	ID              string                    `json:"id"` // The workflow ID of the automation.
+	CreateTime      string                    `json:"create_time"`
+	StartTime       string                    `json:"start_time"`
+	Status          AutomationStatus          `json:"status"`
+	EmailsSent      int                       `json:"emails_sent"` // The total number of emails sent for the automation.
+	Recipients      AutomationRecipients      `json:"recipients"`
+	Settings        AutomationSettings        `json:"settings"`
+	Tracking        CampaignTracking          `json:"tracking"`
+	TriggerSettings AutomationTriggerSettings `json:"trigger_settings"`
+	ReportSummary   AutomationReportSummary   `json:"report_summary"`
+}
+
+// ListAutomationsParams ...
+type ListAutomationsParams struct {
+	PaginationParams
+	Status           AutomationStatus
+	BeforeSendTime   time.Time // Restrict the response to automations sent before the set time.
+	SinceSendTime    time.Time // Restrict the response to automations sent after the set time.
+	BeforeCreateTime time.Time // Restrict the response to automations created before the set time.
+	SinceCreateTime  time.Time // Restrict the response to automations created after the set time.
+}
+
+// ListAutomationsResponse ...
+type ListAutomationsResponse struct {
+	Automations []Automation `json:"automations"`
+	TotalItems  int          `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// ListAutomations returns a page of the classic automations in the account
+func (c *Client) ListAutomations(params *ListAutomationsParams) (*ListAutomationsResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+		if params.Status != "" {
+			query.Set("status", string(params.Status))
+		}
+		setTime(query, "before_send_time", params.BeforeSendTime)
+		setTime(query, "since_send_time", params.SinceSendTime)
+		setTime(query, "before_create_time", params.BeforeCreateTime)
+		setTime(query, "since_create_time", params.SinceCreateTime)
+	}
+	listAutomationsResponse := new(ListAutomationsResponse)
+	err := c.request(
+		"GET",
+		withQuery("/automations", query),
+		nil,
+		listAutomationsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listAutomationsResponse, nil
+}
+
+// GetAutomation returns information about a specific classic automation
+func (c *Client) GetAutomation(workflowID string) (*Automation, error) {
+	automation := new(Automation)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/automations/%s", workflowID),
+		nil,
+		automation,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return automation, nil
+}
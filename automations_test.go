@@ -0,0 +1,74 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAutomations(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/automations", req.URL.Path)
+		assert.Equal(
+			t,
+			"before_create_time=2019-04-01T00%3A00%3A00%2B00%3A00&count=20&status=sending",
+			req.URL.RawQuery,
+		)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"automations": [%s], "total_items": 1}`, automationResponse)
+	})
+	defer server.Close()
+
+	listAutomationsResponse, err := client.ListAutomations(&mailchimp.ListAutomationsParams{
+		PaginationParams: mailchimp.PaginationParams{Count: 20},
+		Status:           mailchimp.AutomationSending,
+		BeforeCreateTime: time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, listAutomationsResponse.TotalItems)
+	automation := listAutomationsResponse.Automations[0]
+	assert.Equal(t, "4e3d2a1b0c", automation.ID)
+	assert.Equal(t, mailchimp.AutomationSending, automation.Status)
+	assert.Equal(t, 512, automation.EmailsSent)
+	assert.Equal(t, "Customers", automation.Recipients.ListName)
+	assert.Equal(t, "Welcome series", automation.Settings.Title)
+	assert.True(t, automation.Tracking.Opens)
+	assert.Equal(t, 46.9, automation.ReportSummary.OpenRate)
+}
+
+func TestGetAutomation(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/automations/4e3d2a1b0c", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, automationResponse)
+	})
+	defer server.Close()
+
+	automation, err := client.GetAutomation("4e3d2a1b0c")
+	assert.NoError(t, err)
+	assert.Equal(t, mailchimp.AutomationSending, automation.Status)
+	assert.Equal(t, "0f6b836652", automation.Recipients.ListID)
+	assert.True(t, automation.Recipients.ListIsActive)
+	assert.Equal(t, mailchimp.AutomationEmailSeries, automation.TriggerSettings.WorkflowType)
+	assert.Equal(t, 3, automation.TriggerSettings.WorkflowEmailsCount)
+	assert.Len(t, automation.TriggerSettings.Runtime.Days, 5)
+	assert.Equal(t, "send_between", automation.TriggerSettings.Runtime.Hours.Type)
+	assert.Equal(t, "17:00", automation.TriggerSettings.Runtime.Hours.SendBetween.End)
+}
+
+func TestDecodeAutomationSavedSegment(t *testing.T) {
+	var recipients mailchimp.AutomationRecipients
+	assert.NoError(t, json.Unmarshal([]byte(`{"list_id": "list_id", "segment_opts": {"saved_segment_id": 5}}`), &recipients))
+	assert.Equal(t, 5, recipients.SegmentOpts.SavedSegmentID)
+	assert.Equal(t, mailchimp.SegmentMatch(""), recipients.SegmentOpts.Match)
+	assert.Empty(t, recipients.SegmentOpts.Conditions)
+}
@@ -0,0 +1,134 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/RichardKnop/go-mailchimp/status"
+)
+
+// MaxBatchMembers is the maximum number of members Mailchimp accepts in a single batch request
+const MaxBatchMembers = 500
+
+// BatchMember - a member to add or update in a batch, see
+// https://developer.mailchimp.com/documentation/mailchimp/reference/lists/#create-post_lists_list_id
+type BatchMember struct {
+	EmailAddress string                 `json:"email_address"`
+	EmailType    string                 `json:"email_type,omitempty"` // Type of email this member asked to get ('html' or 'text').
+	Status       status.MemberStatus    `json:"status"`               // Defaults to subscribed.
+	MergeFields  map[string]interface{} `json:"merge_fields,omitempty"`
+	Interests    map[string]bool        `json:"interests,omitempty"` // The key of this object's properties is the ID of the interest in question.
+	Language     string                 `json:"language,omitempty"`  // If set/detected, the subscriber's language.
+	VIP          bool                   `json:"vip,omitempty"`
+	Location     *MemberLocation        `json:"location,omitempty"`
+
+	IPSignup        string `json:"ip_signup,omitempty"`        // IP address the subscriber signed up from.
+	TimestampSignup string `json:"timestamp_signup,omitempty"` // Date and time the subscriber signed up for the list in ISO 8601 format.
+	IPOpt           string `json:"ip_opt,omitempty"`           // IP address the subscriber confirmed their opt-in status.
+	TimestampOpt    string `json:"timestamp_opt,omitempty"`    // Date and time the subscriber confirmed their opt-in status in ISO 8601 format.
+}
+
+// BatchError - a member that could not be added or updated
+type BatchError struct {
+	EmailAddress string `json:"email_address"`
+	Error        string `json:"error"`
+	ErrorCode    string `json:"error_code"`
+}
+
+// BatchSubscribeResponse ...
+type BatchSubscribeResponse struct {
+	NewMembers     []MemberResponse `json:"new_members"`
+	UpdatedMembers []MemberResponse `json:"updated_members"`
+	Errors         []BatchError     `json:"errors"`
+	TotalCreated   int              `json:"total_created"`
+	TotalUpdated   int              `json:"total_updated"`
+	ErrorCount     int              `json:"error_count"`
+}
+
+// BulkSubscribeOptions - options for batch subscribing members
+type BulkSubscribeOptions struct {
+	UpdateExisting      bool // Whether to update members who are already on the list.
+	SkipMergeValidation bool // Accept members even if required merge fields are missing.
+	SkipDuplicateCheck  bool // Ignore duplicate emails in the request and keep the first occurrence.
+}
+
+// BulkSubscribe adds members to the list, updating existing members if
+// updateExisting is true. Members are sent in chunks of MaxBatchMembers and
+// the results of all chunks are combined. If a chunk fails, the results of the
+// chunks processed so far are returned together with the error.
+func (c *Client) BulkSubscribe(listID string, members []BatchMember, updateExisting bool) (*BatchSubscribeResponse, error) {
+	return c.BulkSubscribeWithOptions(listID, members, &BulkSubscribeOptions{UpdateExisting: updateExisting})
+}
+
+// BulkSubscribeWithOptions works like BulkSubscribe, with options controlling
+// how Mailchimp validates the members
+func (c *Client) BulkSubscribeWithOptions(listID string, members []BatchMember, options *BulkSubscribeOptions) (*BatchSubscribeResponse, error) {
+	if options == nil {
+		options = new(BulkSubscribeOptions)
+	}
+	query := url.Values{}
+	if options.SkipMergeValidation {
+		query.Set("skip_merge_validation", "true")
+	}
+	if options.SkipDuplicateCheck {
+		query.Set("skip_duplicate_check", "true")
+	}
+
+	batchSubscribeResponse := new(BatchSubscribeResponse)
+	for start := 0; start < len(members); start += MaxBatchMembers {
+		end := start + MaxBatchMembers
+		if end > len(members) {
+			end = len(members)
+		}
+
+		// Copy the chunk so defaulting the status does not modify the caller's slice
+		chunk := make([]BatchMember, end-start)
+		copy(chunk, members[start:end])
+		for i := range chunk {
+			if chunk[i].Status == "" {
+				chunk[i].Status = status.Subscribed
+			}
+		}
+
+		params := map[string]interface{}{
+			"members":         chunk,
+			"update_existing": options.UpdateExisting,
+		}
+		chunkResponse := new(BatchSubscribeResponse)
+		err := c.request(
+			"POST",
+			withQuery(fmt.Sprintf("/lists/%s", listID), query),
+			&params,
+			chunkResponse,
+		)
+		if err != nil {
+			return batchSubscribeResponse, err
+		}
+		batchSubscribeResponse.add(chunkResponse)
+	}
+	return batchSubscribeResponse, nil
+}
+
+func (r *BatchSubscribeResponse) add(other *BatchSubscribeResponse) {
+	r.NewMembers = append(r.NewMembers, other.NewMembers...)
+	r.UpdatedMembers = append(r.UpdatedMembers, other.UpdatedMembers...)
+	r.Errors = append(r.Errors, other.Errors...)
+	r.TotalCreated += other.TotalCreated
+	r.TotalUpdated += other.TotalUpdated
+	r.ErrorCount += other.ErrorCount
+}
+
+// UnsubscribeMany unsubscribes the emails using the batch endpoint. Emails
+// which are not yet on the list are added as unsubscribed, which keeps them
+// from being subscribed later by mistake. The response lists which emails
+// were updated, added or failed.
+func (c *Client) UnsubscribeMany(listID string, emails []string) (*BatchSubscribeResponse, error) {
+	members := make([]BatchMember, len(emails))
+	for i, email := range emails {
+		members[i] = BatchMember{
+			EmailAddress: email,
+			Status:       status.Unsubscribed,
+		}
+	}
+	return c.BulkSubscribe(listID, members, true)
+}
@@ -0,0 +1,123 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/RichardKnop/go-mailchimp/status"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkSubscribe(t *testing.T) {
+	var chunkSizes []int
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists/list_id", req.URL.Path)
+
+		var params struct {
+			Members        []mailchimp.BatchMember `json:"members"`
+			UpdateExisting bool                    `json:"update_existing"`
+		}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.True(t, params.UpdateExisting)
+		assert.Equal(t, status.Subscribed, params.Members[0].Status)
+		chunkSizes = append(chunkSizes, len(params.Members))
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{
+			"new_members": [{"email_address": "%s"}],
+			"updated_members": [],
+			"errors": [{"email_address": "bogus", "error": "Invalid email", "error_code": "ERROR_GENERIC"}],
+			"total_created": 1,
+			"total_updated": %d,
+			"error_count": 1
+		}`, params.Members[0].EmailAddress, len(params.Members)-2)
+	})
+	defer server.Close()
+
+	members := make([]mailchimp.BatchMember, 600)
+	for i := range members {
+		members[i].EmailAddress = fmt.Sprintf("member%d@example.com", i)
+	}
+
+	batchSubscribeResponse, err := client.BulkSubscribe("list_id", members, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{500, 100}, chunkSizes)
+	assert.Equal(t, 2, batchSubscribeResponse.TotalCreated)
+	assert.Equal(t, 596, batchSubscribeResponse.TotalUpdated)
+	assert.Equal(t, 2, batchSubscribeResponse.ErrorCount)
+	assert.Len(t, batchSubscribeResponse.Errors, 2)
+	assert.Equal(t, "member500@example.com", batchSubscribeResponse.NewMembers[1].EmailAddress)
+
+	// The caller's members are left untouched
+	assert.Equal(t, status.MemberStatus(""), members[0].Status)
+}
+
+func TestBulkSubscribeWithOptions(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists/list_id", req.URL.Path)
+		assert.Equal(t, "skip_duplicate_check=true&skip_merge_validation=true", req.URL.RawQuery)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, false, params["update_existing"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"new_members": [{"email_address": "john@reese.com"}], "total_created": 1}`)
+	})
+	defer server.Close()
+
+	batchSubscribeResponse, err := client.BulkSubscribeWithOptions(
+		"list_id",
+		[]mailchimp.BatchMember{{EmailAddress: "john@reese.com"}},
+		&mailchimp.BulkSubscribeOptions{SkipMergeValidation: true, SkipDuplicateCheck: true},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, batchSubscribeResponse.TotalCreated)
+}
+
+func TestBulkSubscribeError(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(404)
+		fmt.Fprint(rw, notFoundErrorResponse)
+	})
+	defer server.Close()
+
+	_, err := client.BulkSubscribe("list_id", []mailchimp.BatchMember{{EmailAddress: "john@reese.com"}}, false)
+	assert.Equal(t, "Error 404 Resource Not Found (The requested resource could not be found.)", err.Error())
+}
+
+func TestUnsubscribeMany(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		var params struct {
+			Members        []mailchimp.BatchMember `json:"members"`
+			UpdateExisting bool                    `json:"update_existing"`
+		}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.True(t, params.UpdateExisting)
+		assert.Equal(t, []mailchimp.BatchMember{
+			{EmailAddress: "john@reese.com", Status: status.Unsubscribed},
+			{EmailAddress: "harold@finch.com", Status: status.Unsubscribed},
+		}, params.Members)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"new_members": [],
+			"updated_members": [{"email_address": "john@reese.com", "status": "unsubscribed"}, {"email_address": "harold@finch.com", "status": "unsubscribed"}],
+			"errors": [],
+			"total_created": 0,
+			"total_updated": 2,
+			"error_count": 0
+		}`)
+	})
+	defer server.Close()
+
+	batchSubscribeResponse, err := client.UnsubscribeMany("list_id", []string{"john@reese.com", "harold@finch.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, batchSubscribeResponse.TotalUpdated)
+	assert.Equal(t, status.Unsubscribed, batchSubscribeResponse.UpdatedMembers[1].Status)
+}
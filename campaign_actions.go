@@ -0,0 +1,155 @@
+package mailchimp
+
+import (
+	"fmt"
+	"time"
+)
+
+// Test email send types
+const (
+	TestEmailHTML      = "html"
+	TestEmailPlaintext = "plaintext"
+)
+
+// Resend shortcut types
+const (
+	ResendToNonOpeners     = "to_non_openers"
+	ResendToNewSubscribers = "to_new_subscribers"
+	ResendToNonClickers    = "to_non_clickers"
+	ResendToNonPurchasers  = "to_non_purchasers"
+)
+
+// BatchDelivery - sends a campaign in batches instead of all at once
+type BatchDelivery struct {
+	BatchDelay int `json:"batch_delay"` // The delay, in minutes, between batches.
+	BatchCount int `json:"batch_count"` // The number of batches for the campaign send.
+}
+
+// ScheduleOptions - options for scheduling a campaign
+type ScheduleOptions struct {
+	Timewarp      bool           // Deliver at the scheduled time in each recipient's time zone.
+	BatchDelivery *BatchDelivery // Cannot be combined with Timewarp.
+}
+
+// SendCampaign sends a campaign immediately. If the campaign fails its send
+// checklist, a *CampaignNotReadyError listing the blocking checklist items is
+// returned, which matches ErrCampaignNotReady (see errors.Is).
+func (c *Client) SendCampaign(campaignID string) error {
+	err := c.campaignAction(campaignID, "send", nil)
+	errorResponse, ok := err.(*ErrorResponse)
+	if !ok || !errorResponse.Is(ErrCampaignNotReady) {
+		return err
+	}
+	sendChecklist, checklistErr := c.GetSendChecklist(campaignID)
+	if checklistErr != nil {
+		// Still report the failed send rather than the checklist error
+		return err
+	}
+	return &CampaignNotReadyError{
+		ErrorResponse: *errorResponse,
+		Blocking:      sendChecklist.Blocking(),
+	}
+}
+
+// ScheduleCampaign schedules a campaign for delivery. Mailchimp only accepts
+// times on the quarter-hour, other times return ErrInvalidScheduleTime
+// without calling the API. Likewise options combining Timewarp with
+// BatchDelivery return ErrInvalidScheduleOptions.
+func (c *Client) ScheduleCampaign(campaignID string, scheduleTime time.Time, options *ScheduleOptions) error {
+	if scheduleTime.Minute()%15 != 0 || scheduleTime.Second() != 0 || scheduleTime.Nanosecond() != 0 {
+		return ErrInvalidScheduleTime
+	}
+	if options != nil && options.Timewarp && options.BatchDelivery != nil {
+		return ErrInvalidScheduleOptions
+	}
+	params := map[string]interface{}{
+		"schedule_time": scheduleTime.UTC().Format(timeFormat),
+	}
+	if options != nil {
+		if options.Timewarp {
+			params["timewarp"] = true
+		}
+		if options.BatchDelivery != nil {
+			params["batch_delivery"] = options.BatchDelivery
+		}
+	}
+	return c.campaignAction(campaignID, "schedule", &params)
+}
+
+// UnscheduleCampaign unschedules a scheduled campaign, which returns it to draft
+func (c *Client) UnscheduleCampaign(campaignID string) error {
+	return c.campaignAction(campaignID, "unschedule", nil)
+}
+
+// SendTestEmail sends a test version of a campaign to the emails. The send
+// type is either TestEmailHTML or TestEmailPlaintext.
+func (c *Client) SendTestEmail(campaignID string, emails []string, sendType string) error {
+	params := map[string]interface{}{
+		"test_emails": emails,
+		"send_type":   sendType,
+	}
+	return c.campaignAction(campaignID, "test", &params)
+}
+
+// PauseCampaign pauses an RSS campaign
+func (c *Client) PauseCampaign(campaignID string) error {
+	return c.campaignAction(campaignID, "pause", nil)
+}
+
+// ResumeCampaign resumes a paused RSS campaign
+func (c *Client) ResumeCampaign(campaignID string) error {
+	return c.campaignAction(campaignID, "resume", nil)
+}
+
+// CancelSend stops a campaign which is being sent. Only available to
+// Mailchimp Pro accounts.
+func (c *Client) CancelSend(campaignID string) error {
+	return c.campaignAction(campaignID, "cancel-send", nil)
+}
+
+// ReplicateCampaign creates a copy of a campaign and returns the new draft
+func (c *Client) ReplicateCampaign(campaignID string) (*Campaign, error) {
+	campaign := new(Campaign)
+	err := c.request(
+		"POST",
+		fmt.Sprintf("/campaigns/%s/actions/replicate", campaignID),
+		nil,
+		campaign,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// CreateResend creates a draft resending a sent campaign to part of its
+// recipients, e.g. ResendToNonOpeners. Mailchimp resends to non-openers if
+// shortcutType is empty. The new campaign can then be scheduled.
+func (c *Client) CreateResend(campaignID string, shortcutType string) (*Campaign, error) {
+	var params interface{}
+	if shortcutType != "" {
+		params = map[string]interface{}{
+			"shortcut_type": shortcutType,
+		}
+	}
+	campaign := new(Campaign)
+	err := c.request(
+		"POST",
+		fmt.Sprintf("/campaigns/%s/actions/create-resend", campaignID),
+		params,
+		campaign,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+func (c *Client) campaignAction(campaignID string, action string, params interface{}) error {
+	return c.request(
+		"POST",
+		fmt.Sprintf("/campaigns/%s/actions/%s", campaignID, action),
+		params,
+		nil,
+	)
+}
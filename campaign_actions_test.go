@@ -0,0 +1,287 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendCampaign(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57/actions/send", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.SendCampaign("42694e9e57"))
+}
+
+func TestSendCampaignNotReady(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == "GET" {
+			assert.Equal(t, "/campaigns/42694e9e57/send-checklist", req.URL.Path)
+
+			rw.WriteHeader(200)
+			fmt.Fprint(rw, `{
+				"is_ready": false,
+				"items": [
+					{"type": "success", "id": 1, "heading": "List", "details": "Customers"},
+					{"type": "error", "id": 2, "heading": "Subject line", "details": "Your campaign has no subject line."}
+				]
+			}`)
+			return
+		}
+		rw.WriteHeader(400)
+		fmt.Fprint(rw, campaignNotReadyErrorResponse)
+	})
+	defer server.Close()
+
+	err := client.SendCampaign("42694e9e57")
+	assert.True(t, errors.Is(err, mailchimp.ErrCampaignNotReady))
+	assert.False(t, errors.Is(err, mailchimp.ErrMemberExists))
+
+	notReadyErr, ok := err.(*mailchimp.CampaignNotReadyError)
+	if assert.True(t, ok) {
+		assert.Equal(t, []mailchimp.SendChecklistItem{
+			{Type: mailchimp.ChecklistError, ID: 2, Heading: "Subject line", Details: "Your campaign has no subject line."},
+		}, notReadyErr.Blocking)
+	}
+	assert.Contains(t, err.Error(), "Blocking: Subject line (Your campaign has no subject line.)")
+
+	var errorResponse *mailchimp.ErrorResponse
+	if assert.True(t, errors.As(err, &errorResponse)) {
+		assert.Equal(t, 400, errorResponse.Status)
+		assert.Equal(t, "Your Campaign is not ready to send.", errorResponse.Detail)
+	}
+}
+
+func TestSendCampaignNotReadyChecklistFails(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == "GET" {
+			rw.WriteHeader(500)
+			fmt.Fprint(rw, `{"title": "Internal Server Error", "status": 500}`)
+			return
+		}
+		rw.WriteHeader(400)
+		fmt.Fprint(rw, campaignNotReadyErrorResponse)
+	})
+	defer server.Close()
+
+	err := client.SendCampaign("42694e9e57")
+	assert.True(t, errors.Is(err, mailchimp.ErrCampaignNotReady))
+	_, ok := err.(*mailchimp.ErrorResponse)
+	assert.True(t, ok)
+}
+
+func TestCampaignNotReadyWording(t *testing.T) {
+	// Matching does not depend on the exact wording of the detail
+	for _, detail := range []string{
+		"Your Campaign is not ready to send.",
+		"Your campaign is not ready to send",
+		"This campaign is NOT READY TO SEND: it has no content.",
+	} {
+		err := &mailchimp.ErrorResponse{Title: "Bad Request", Status: 400, Detail: detail}
+		assert.True(t, errors.Is(err, mailchimp.ErrCampaignNotReady), detail)
+	}
+
+	err := &mailchimp.ErrorResponse{Title: "Internal Server Error", Status: 500, Detail: "Your Campaign is not ready to send."}
+	assert.False(t, errors.Is(err, mailchimp.ErrCampaignNotReady))
+}
+
+func TestScheduleCampaign(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57/actions/schedule", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"schedule_time": "2019-04-02T13:45:00+00:00",
+			"batch_delivery": map[string]interface{}{
+				"batch_delay": float64(10),
+				"batch_count": float64(4),
+			},
+		}, params)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	// 15:45 in UTC+2 is 13:45 UTC
+	scheduleTime := time.Date(2019, 4, 2, 15, 45, 0, 0, time.FixedZone("CEST", 2*60*60))
+	err := client.ScheduleCampaign("42694e9e57", scheduleTime, &mailchimp.ScheduleOptions{
+		BatchDelivery: &mailchimp.BatchDelivery{BatchDelay: 10, BatchCount: 4},
+	})
+	assert.NoError(t, err)
+}
+
+func TestScheduleCampaignTimewarp(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, true, params["timewarp"])
+		_, ok := params["batch_delivery"]
+		assert.False(t, ok)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	scheduleTime := time.Date(2019, 4, 2, 9, 0, 0, 0, time.UTC)
+	assert.NoError(t, client.ScheduleCampaign("42694e9e57", scheduleTime, &mailchimp.ScheduleOptions{Timewarp: true}))
+}
+
+func TestScheduleCampaignInvalidTime(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		t.Error("the API should not be called")
+	})
+	defer server.Close()
+
+	scheduleTime := time.Date(2019, 4, 2, 9, 10, 0, 0, time.UTC)
+	err := client.ScheduleCampaign("42694e9e57", scheduleTime, nil)
+	assert.Equal(t, mailchimp.ErrInvalidScheduleTime, err)
+
+	scheduleTime = time.Date(2019, 4, 2, 9, 15, 30, 0, time.UTC)
+	err = client.ScheduleCampaign("42694e9e57", scheduleTime, nil)
+	assert.Equal(t, mailchimp.ErrInvalidScheduleTime, err)
+}
+
+func TestScheduleCampaignInvalidOptions(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		t.Error("the API should not be called")
+	})
+	defer server.Close()
+
+	scheduleTime := time.Date(2019, 4, 2, 9, 0, 0, 0, time.UTC)
+	err := client.ScheduleCampaign("42694e9e57", scheduleTime, &mailchimp.ScheduleOptions{
+		Timewarp:      true,
+		BatchDelivery: &mailchimp.BatchDelivery{BatchDelay: 10, BatchCount: 4},
+	})
+	assert.Equal(t, mailchimp.ErrInvalidScheduleOptions, err)
+}
+
+func TestUnscheduleCampaign(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57/actions/unschedule", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.UnscheduleCampaign("42694e9e57"))
+}
+
+func TestSendTestEmail(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57/actions/test", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"test_emails": []interface{}{"harold@finch.com", "john@reese.com"},
+			"send_type":   "html",
+		}, params)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	err := client.SendTestEmail("42694e9e57", []string{"harold@finch.com", "john@reese.com"}, mailchimp.TestEmailHTML)
+	assert.NoError(t, err)
+}
+
+func TestPauseCampaign(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57/actions/pause", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.PauseCampaign("42694e9e57"))
+}
+
+func TestResumeCampaign(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57/actions/resume", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.ResumeCampaign("42694e9e57"))
+}
+
+func TestReplicateCampaign(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57/actions/replicate", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, campaignResponse)
+	})
+	defer server.Close()
+
+	campaign, err := client.ReplicateCampaign("42694e9e57")
+	assert.NoError(t, err)
+	assert.Equal(t, mailchimp.CampaignSave, campaign.Status)
+}
+
+func TestCancelSend(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57/actions/cancel-send", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.CancelSend("42694e9e57"))
+}
+
+func TestCreateResend(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57/actions/create-resend", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{"shortcut_type": "to_non_openers"}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, campaignResponse)
+	})
+	defer server.Close()
+
+	campaign, err := client.CreateResend("42694e9e57", mailchimp.ResendToNonOpeners)
+	assert.NoError(t, err)
+	assert.Equal(t, "42694e9e57", campaign.ID)
+}
+
+func TestCreateResendDefault(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.Empty(t, body)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, campaignResponse)
+	})
+	defer server.Close()
+
+	_, err := client.CreateResend("42694e9e57", "")
+	assert.NoError(t, err)
+}
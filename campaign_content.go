@@ -0,0 +1,58 @@
+package mailchimp
+
+import (
+	"fmt"
+)
+
+// CampaignContentTemplate - a template to use as campaign content
+type CampaignContentTemplate struct {
+	ID       int               `json:"id"`
+	Sections map[string]string `json:"sections,omitempty"` // Content for the editable sections of the template, keyed by section name.
+}
+
+// CampaignContentParams - set one of HTML, URL or Template. PlainText is
+// generated from the HTML if empty, and is the only content of plaintext
+// campaigns.
+type CampaignContentParams struct {
+	PlainText string                   `json:"plain_text,omitempty"`
+	HTML      string                   `json:"html,omitempty"` // The raw HTML of the campaign.
+	URL       string                   `json:"url,omitempty"`  // Import the HTML of the campaign from this URL.
+	Template  *CampaignContentTemplate `json:"template,omitempty"`
+}
+
+// CampaignContent - see https://developer.mailchimp.com/documentation/mailchimp/reference/campaigns/content/
+type CampaignContent struct {
+	PlainText   string `json:"plain_text"`
+	HTML        string `json:"html"`
+	ArchiveHTML string `json:"archive_html"` // The archive HTML of the campaign.
+}
+
+// SetCampaignContent sets the content of a campaign
+func (c *Client) SetCampaignContent(campaignID string, params *CampaignContentParams) (*CampaignContent, error) {
+	campaignContent := new(CampaignContent)
+	err := c.request(
+		"PUT",
+		fmt.Sprintf("/campaigns/%s/content", campaignID),
+		params,
+		campaignContent,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return campaignContent, nil
+}
+
+// GetCampaignContent returns the content of a campaign
+func (c *Client) GetCampaignContent(campaignID string) (*CampaignContent, error) {
+	campaignContent := new(CampaignContent)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/campaigns/%s/content", campaignID),
+		nil,
+		campaignContent,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return campaignContent, nil
+}
@@ -0,0 +1,75 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetCampaignContentHTML(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57/content", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"html": "<h1>April news</h1><p>What we shipped this month</p>",
+		}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, campaignContentResponse)
+	})
+	defer server.Close()
+
+	campaignContent, err := client.SetCampaignContent("42694e9e57", &mailchimp.CampaignContentParams{
+		HTML: "<h1>April news</h1><p>What we shipped this month</p>",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "April news\n\nWhat we shipped this month", campaignContent.PlainText)
+}
+
+func TestSetCampaignContentTemplate(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"template": map[string]interface{}{
+				"id":       float64(2000),
+				"sections": map[string]interface{}{"body": "<p>Hello</p>"},
+			},
+		}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, campaignContentResponse)
+	})
+	defer server.Close()
+
+	_, err := client.SetCampaignContent("42694e9e57", &mailchimp.CampaignContentParams{
+		Template: &mailchimp.CampaignContentTemplate{
+			ID:       2000,
+			Sections: map[string]string{"body": "<p>Hello</p>"},
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetCampaignContent(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57/content", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, campaignContentResponse)
+	})
+	defer server.Close()
+
+	campaignContent, err := client.GetCampaignContent("42694e9e57")
+	assert.NoError(t, err)
+	assert.Equal(t, "<h1>April news</h1><p>What we shipped this month</p>", campaignContent.HTML)
+	assert.Equal(t, "<html><body><h1>April news</h1></body></html>", campaignContent.ArchiveHTML)
+}
@@ -0,0 +1,103 @@
+package mailchimp
+
+import (
+	"fmt"
+)
+
+// CampaignFeedback - see https://developer.mailchimp.com/documentation/mailchimp/reference/campaigns/feedback/
+type CampaignFeedback struct {
+	FeedbackID       int    `json:"feedback_id"`
+	ParentCampaignID string `json:"parent_campaign_id"`
+	BlockID          int    `json:"block_id"` // The block of the campaign the feedback refers to.
+	Message          string `json:"message"`
+	IsComplete       bool   `json:"is_complete"` // Whether the feedback has been addressed.
+	CreatedBy        string `json:"created_by"`
+	CreatedAt        string `json:"created_at"`
+	UpdatedAt        string `json:"updated_at"`
+	Source           string `json:"source"` // How the feedback was added, e.g. api or email.
+	CampaignID       string `json:"campaign_id"`
+}
+
+// CampaignFeedbackParams - used both to create and to update feedback
+type CampaignFeedbackParams struct {
+	Message    string `json:"message,omitempty"`
+	BlockID    int    `json:"block_id,omitempty"`
+	IsComplete *bool  `json:"is_complete,omitempty"`
+}
+
+// ListCampaignFeedbackResponse ...
+type ListCampaignFeedbackResponse struct {
+	Feedback   []CampaignFeedback `json:"feedback"`
+	CampaignID string             `json:"campaign_id"`
+	TotalItems int                `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// ListCampaignFeedback returns the feedback of a campaign
+func (c *Client) ListCampaignFeedback(campaignID string) (*ListCampaignFeedbackResponse, error) {
+	listCampaignFeedbackResponse := new(ListCampaignFeedbackResponse)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/campaigns/%s/feedback", campaignID),
+		nil,
+		listCampaignFeedbackResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listCampaignFeedbackResponse, nil
+}
+
+// GetCampaignFeedback returns a specific feedback message of a campaign
+func (c *Client) GetCampaignFeedback(campaignID string, feedbackID int) (*CampaignFeedback, error) {
+	campaignFeedback := new(CampaignFeedback)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/campaigns/%s/feedback/%d", campaignID, feedbackID),
+		nil,
+		campaignFeedback,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return campaignFeedback, nil
+}
+
+// CreateCampaignFeedback adds a feedback message to a campaign
+func (c *Client) CreateCampaignFeedback(campaignID string, params *CampaignFeedbackParams) (*CampaignFeedback, error) {
+	campaignFeedback := new(CampaignFeedback)
+	err := c.request(
+		"POST",
+		fmt.Sprintf("/campaigns/%s/feedback", campaignID),
+		params,
+		campaignFeedback,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return campaignFeedback, nil
+}
+
+// UpdateCampaignFeedback updates a specific feedback message of a campaign
+func (c *Client) UpdateCampaignFeedback(campaignID string, feedbackID int, params *CampaignFeedbackParams) (*CampaignFeedback, error) {
+	campaignFeedback := new(CampaignFeedback)
+	err := c.request(
+		"PATCH",
+		fmt.Sprintf("/campaigns/%s/feedback/%d", campaignID, feedbackID),
+		params,
+		campaignFeedback,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return campaignFeedback, nil
+}
+
+// DeleteCampaignFeedback deletes a specific feedback message of a campaign
+func (c *Client) DeleteCampaignFeedback(campaignID string, feedbackID int) error {
+	return c.request(
+		"DELETE",
+		fmt.Sprintf("/campaigns/%s/feedback/%d", campaignID, feedbackID),
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,84 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCampaignFeedback(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57/feedback", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"message":  "Please fix the typo in the header.",
+			"block_id": float64(3),
+		}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, campaignFeedbackResponse)
+	})
+	defer server.Close()
+
+	campaignFeedback, err := client.CreateCampaignFeedback("42694e9e57", &mailchimp.CampaignFeedbackParams{
+		Message: "Please fix the typo in the header.",
+		BlockID: 3,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 12, campaignFeedback.FeedbackID)
+	assert.Equal(t, "api", campaignFeedback.Source)
+}
+
+func TestListCampaignFeedback(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57/feedback", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"feedback": [%s], "campaign_id": "42694e9e57", "total_items": 1}`, campaignFeedbackResponse)
+	})
+	defer server.Close()
+
+	listCampaignFeedbackResponse, err := client.ListCampaignFeedback("42694e9e57")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, listCampaignFeedbackResponse.TotalItems)
+	assert.Equal(t, "Harold Finch", listCampaignFeedbackResponse.Feedback[0].CreatedBy)
+}
+
+func TestUpdateCampaignFeedback(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57/feedback/12", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{"is_complete": true}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, campaignFeedbackResponse)
+	})
+	defer server.Close()
+
+	isComplete := true
+	_, err := client.UpdateCampaignFeedback("42694e9e57", 12, &mailchimp.CampaignFeedbackParams{IsComplete: &isComplete})
+	assert.NoError(t, err)
+}
+
+func TestDeleteCampaignFeedback(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57/feedback/12", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.DeleteCampaignFeedback("42694e9e57", 12))
+}
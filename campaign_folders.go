@@ -0,0 +1,100 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Folder - a folder used to organize campaigns or templates
+type Folder struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Count int    `json:"count"` // The number of items in the folder.
+}
+
+// ListCampaignFoldersResponse ...
+type ListCampaignFoldersResponse struct {
+	Folders    []Folder `json:"folders"`
+	TotalItems int      `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// ListCampaignFolders returns a page of the campaign folders in the account
+func (c *Client) ListCampaignFolders(params *PaginationParams) (*ListCampaignFoldersResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+	}
+	listCampaignFoldersResponse := new(ListCampaignFoldersResponse)
+	err := c.request(
+		"GET",
+		withQuery("/campaign-folders", query),
+		nil,
+		listCampaignFoldersResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listCampaignFoldersResponse, nil
+}
+
+// GetCampaignFolder returns a specific campaign folder
+func (c *Client) GetCampaignFolder(folderID string) (*Folder, error) {
+	folder := new(Folder)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/campaign-folders/%s", folderID),
+		nil,
+		folder,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return folder, nil
+}
+
+// CreateCampaignFolder creates a new campaign folder
+func (c *Client) CreateCampaignFolder(name string) (*Folder, error) {
+	params := map[string]interface{}{
+		"name": name,
+	}
+	folder := new(Folder)
+	err := c.request(
+		"POST",
+		"/campaign-folders",
+		&params,
+		folder,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return folder, nil
+}
+
+// UpdateCampaignFolder renames a specific campaign folder
+func (c *Client) UpdateCampaignFolder(folderID string, name string) (*Folder, error) {
+	params := map[string]interface{}{
+		"name": name,
+	}
+	folder := new(Folder)
+	err := c.request(
+		"PATCH",
+		fmt.Sprintf("/campaign-folders/%s", folderID),
+		&params,
+		folder,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return folder, nil
+}
+
+// DeleteCampaignFolder deletes a specific campaign folder. The campaigns in
+// the folder are kept and marked as unfiled.
+func (c *Client) DeleteCampaignFolder(folderID string) error {
+	return c.request(
+		"DELETE",
+		fmt.Sprintf("/campaign-folders/%s", folderID),
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,79 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCampaignFolders(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/campaign-folders", req.URL.Path)
+		assert.Equal(t, "count=100", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"folders": [{"id": "f1", "name": "acme/2019-04", "count": 3}], "total_items": 1}`)
+	})
+	defer server.Close()
+
+	listCampaignFoldersResponse, err := client.ListCampaignFolders(&mailchimp.PaginationParams{Count: 100})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, listCampaignFoldersResponse.TotalItems)
+	assert.Equal(t, "acme/2019-04", listCampaignFoldersResponse.Folders[0].Name)
+	assert.Equal(t, 3, listCampaignFoldersResponse.Folders[0].Count)
+}
+
+func TestCreateCampaignFolder(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/campaign-folders", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{"name": "acme/2019-04"}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"id": "f1", "name": "acme/2019-04", "count": 0}`)
+	})
+	defer server.Close()
+
+	folder, err := client.CreateCampaignFolder("acme/2019-04")
+	assert.NoError(t, err)
+	assert.Equal(t, "f1", folder.ID)
+}
+
+func TestUpdateCampaignFolder(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "/campaign-folders/f1", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{"name": "acme/2019-05"}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"id": "f1", "name": "acme/2019-05", "count": 3}`)
+	})
+	defer server.Close()
+
+	folder, err := client.UpdateCampaignFolder("f1", "acme/2019-05")
+	assert.NoError(t, err)
+	assert.Equal(t, "acme/2019-05", folder.Name)
+}
+
+func TestDeleteCampaignFolder(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/campaign-folders/f1", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.DeleteCampaignFolder("f1"))
+}
@@ -0,0 +1,52 @@
+package mailchimp
+
+import (
+	"fmt"
+)
+
+// Send checklist item types
+const (
+	ChecklistSuccess = "success"
+	ChecklistWarning = "warning"
+	ChecklistError   = "error" // The item blocks the campaign from being sent.
+)
+
+// SendChecklistItem - a single check of a campaign's send checklist
+type SendChecklistItem struct {
+	Type    string `json:"type"` // One of the Checklist* constants.
+	ID      int    `json:"id"`
+	Heading string `json:"heading"`
+	Details string `json:"details"`
+}
+
+// SendChecklist - see https://developer.mailchimp.com/documentation/mailchimp/reference/campaigns/send-checklist/
+type SendChecklist struct {
+	IsReady bool                `json:"is_ready"` // Whether the campaign is ready to send.
+	Items   []SendChecklistItem `json:"items"`
+}
+
+// Blocking returns the checklist items which keep the campaign from being sent
+func (s *SendChecklist) Blocking() []SendChecklistItem {
+	var blocking []SendChecklistItem
+	for _, item := range s.Items {
+		if item.Type == ChecklistError {
+			blocking = append(blocking, item)
+		}
+	}
+	return blocking
+}
+
+// GetSendChecklist returns the send checklist of a campaign
+func (c *Client) GetSendChecklist(campaignID string) (*SendChecklist, error) {
+	sendChecklist := new(SendChecklist)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/campaigns/%s/send-checklist", campaignID),
+		nil,
+		sendChecklist,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return sendChecklist, nil
+}
@@ -0,0 +1,38 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSendChecklist(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57/send-checklist", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"is_ready": false,
+			"items": [
+				{"type": "success", "id": 1, "heading": "List", "details": "MailChimp will deliver to the entire list."},
+				{"type": "warning", "id": 2, "heading": "Preview text", "details": "You haven't added preview text."},
+				{"type": "error", "id": 3, "heading": "Subject line", "details": "You need to add a subject line."}
+			]
+		}`)
+	})
+	defer server.Close()
+
+	sendChecklist, err := client.GetSendChecklist("42694e9e57")
+	assert.NoError(t, err)
+	assert.False(t, sendChecklist.IsReady)
+	assert.Len(t, sendChecklist.Items, 3)
+
+	blocking := sendChecklist.Blocking()
+	assert.Len(t, blocking, 1)
+	assert.Equal(t, mailchimp.ChecklistError, blocking[0].Type)
+	assert.Equal(t, "Subject line", blocking[0].Heading)
+}
@@ -0,0 +1,42 @@
+package mailchimp
+
+// WinnerCriteria - how the winning combination of a variate campaign is picked
+type WinnerCriteria string
+
+// Winner criteria
+const (
+	WinnerOpens        WinnerCriteria = "opens"
+	WinnerClicks       WinnerCriteria = "clicks"
+	WinnerManual       WinnerCriteria = "manual"
+	WinnerTotalRevenue WinnerCriteria = "total_revenue"
+)
+
+// VariateCombination - a combination of the tested values sent to part of the
+// test recipients. Fields other than ID and Recipients are indexes into the
+// matching slices of the variate settings.
+type VariateCombination struct {
+	ID                 string `json:"id"`
+	SubjectLine        int    `json:"subject_line"`
+	SendTime           int    `json:"send_time"`
+	FromName           int    `json:"from_name"`
+	ReplyTo            int    `json:"reply_to"`
+	ContentDescription int    `json:"content_description"`
+	Recipients         int    `json:"recipients"` // The number of recipients of the combination.
+}
+
+// VariateSettings - the settings of a variate (A/B test) campaign. Between
+// two and eight values can be tested in total.
+type VariateSettings struct {
+	WinnerCriteria   WinnerCriteria `json:"winner_criteria"`
+	WaitTime         int            `json:"wait_time,omitempty"` // Minutes to wait before picking the winner.
+	TestSize         int            `json:"test_size,omitempty"` // The percentage of recipients to send the test combinations to.
+	SubjectLines     []string       `json:"subject_lines,omitempty"`
+	SendTimes        []string       `json:"send_times,omitempty"`
+	FromNames        []string       `json:"from_names,omitempty"`
+	ReplyToAddresses []string       `json:"reply_to_addresses,omitempty"`
+
+	WinningCombinationID string               `json:"winning_combination_id,omitempty"` // Read only.
+	WinningCampaignID    string               `json:"winning_campaign_id,omitempty"`    // Read only.
+	Contents             []string             `json:"contents,omitempty"`               // Read only, descriptions of the tested contents.
+	Combinations         []VariateCombination `json:"combinations,omitempty"`           // Read only.
+}
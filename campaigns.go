@@ -0,0 +1,295 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// CampaignType - the type of a campaign
+type CampaignType string
+
+// Campaign types
+const (
+	CampaignRegular   CampaignType = "regular"
+	CampaignPlaintext CampaignType = "plaintext"
+	CampaignRSS       CampaignType = "rss"
+	CampaignVariate   CampaignType = "variate" // A/B test campaign.
+)
+
+// CampaignStatus - the status of a campaign
+type CampaignStatus string
+
+// Campaign statuses
+const (
+	CampaignSave      CampaignStatus = "save" // A draft.
+	CampaignPaused    CampaignStatus = "paused"
+	CampaignSchedule  CampaignStatus = "schedule"
+	CampaignSending   CampaignStatus = "sending"
+	CampaignSent      CampaignStatus = "sent"
+	CampaignCanceled  CampaignStatus = "canceled"
+	CampaignCanceling CampaignStatus = "canceling"
+)
+
+// CampaignSegmentOptions - restricts the campaign recipients to part of the
+// list, either to an existing segment or to members matching conditions.
+// Conditions can be built with NewSegmentOptions, e.g.
+//
+//	NewCampaignSegmentOptions(NewSegmentOptions(SegmentMatchAll).MergeField("PLAN", OpIs, "pro"))
+type CampaignSegmentOptions struct {
+	SavedSegmentID int                `json:"saved_segment_id,omitempty"` // Send to the members of an existing segment or tag.
+	Match          SegmentMatch       `json:"match,omitempty"`
+	Conditions     []SegmentCondition `json:"conditions,omitempty"`
+}
+
+// NewCampaignSegmentOptions returns campaign segment options with the match
+// and conditions of segmentOptions
+func NewCampaignSegmentOptions(segmentOptions *SegmentOptions) *CampaignSegmentOptions {
+	return &CampaignSegmentOptions{
+		Match:      segmentOptions.Match,
+		Conditions: segmentOptions.Conditions,
+	}
+}
+
+// CampaignRecipients - the list and optional segment a campaign is sent to
+type CampaignRecipients struct {
+	ListID         string                  `json:"list_id"`
+	SegmentOpts    *CampaignSegmentOptions `json:"segment_opts,omitempty"`
+	ListName       string                  `json:"list_name,omitempty"`       // Read only.
+	SegmentText    string                  `json:"segment_text,omitempty"`    // Read only, a description of the segment used.
+	RecipientCount int                     `json:"recipient_count,omitempty"` // Read only, the number of recipients.
+}
+
+// CampaignSettings - the settings of a campaign. Subject line, from name
+// and reply to are required before the campaign can be sent.
+type CampaignSettings struct {
+	SubjectLine string `json:"subject_line,omitempty"`
+	PreviewText string `json:"preview_text,omitempty"` // The preview text shown in the inbox after the subject line.
+	Title       string `json:"title,omitempty"`        // The title of the campaign, only used in the Mailchimp app.
+	FromName    string `json:"from_name,omitempty"`
+	ReplyTo     string `json:"reply_to,omitempty"` // The reply-to email address of the campaign.
+	ToName      string `json:"to_name,omitempty"`  // The 'To' name, may contain merge tags such as *|FNAME|*.
+	TemplateID  int    `json:"template_id,omitempty"`
+	FolderID    string `json:"folder_id,omitempty"` // The campaign folder to file the campaign in.
+}
+
+// CampaignTracking - the tracking options of a campaign. All fields are
+// sent, so when updating start from the tracking returned by GetCampaign.
+type CampaignTracking struct {
+	Opens           bool   `json:"opens"`
+	HTMLClicks      bool   `json:"html_clicks"`
+	TextClicks      bool   `json:"text_clicks"`
+	GoalTracking    bool   `json:"goal_tracking"`
+	Ecomm360        bool   `json:"ecomm360"`
+	GoogleAnalytics string `json:"google_analytics"` // The custom slug for Google Analytics tracking (max of 50 bytes).
+	Clicktale       string `json:"clicktale"`        // The custom slug for ClickTale tracking (max of 50 bytes).
+}
+
+// RSS campaign frequencies
+const (
+	RSSDaily   = "daily"
+	RSSWeekly  = "weekly"
+	RSSMonthly = "monthly"
+)
+
+// RSSDailySend - the days of the week a daily RSS campaign is sent on
+type RSSDailySend struct {
+	Sunday    bool `json:"sunday"`
+	Monday    bool `json:"monday"`
+	Tuesday   bool `json:"tuesday"`
+	Wednesday bool `json:"wednesday"`
+	Thursday  bool `json:"thursday"`
+	Friday    bool `json:"friday"`
+	Saturday  bool `json:"saturday"`
+}
+
+// RSSSchedule - when an RSS campaign is sent
+type RSSSchedule struct {
+	Hour            int           `json:"hour"` // The hour to send the campaign in local time, from 0 to 23.
+	DailySend       *RSSDailySend `json:"daily_send,omitempty"`
+	WeeklySendDay   string        `json:"weekly_send_day,omitempty"`   // The day of the week to send a weekly campaign, e.g. monday.
+	MonthlySendDate float64       `json:"monthly_send_date,omitempty"` // The day of the month to send a monthly campaign, 0 for the last day.
+}
+
+// RSSOptions - the options of an RSS campaign
+type RSSOptions struct {
+	FeedURL         string      `json:"feed_url"`
+	Frequency       string      `json:"frequency"` // One of RSSDaily, RSSWeekly or RSSMonthly.
+	Schedule        RSSSchedule `json:"schedule"`
+	LastSent        string      `json:"last_sent,omitempty"` // Read only, the date and time the campaign was last sent.
+	ConstrainRSSImg bool        `json:"constrain_rss_img"`   // Whether to add CSS to images in the RSS feed to constrain their width.
+}
+
+// SocialCard - the preview shown when the campaign is shared on social media
+type SocialCard struct {
+	ImageURL    string `json:"image_url,omitempty"`
+	Description string `json:"description,omitempty"`
+	Title       string `json:"title,omitempty"`
+}
+
+// Campaign - see https://developer.mailchimp.com/documentation/mailchimp/reference/campaigns/
+type Campaign struct {
+	ID              string             `json:"id"`
+	WebID           int                `json:"web_id"` // The ID used in the Mailchimp web application.
+	Type            CampaignType       `json:"type"`
+	CreateTime      string             `json:"create_time"`
+	ArchiveURL      string             `json:"archive_url"`      // The link to the campaign's archive version.
+	LongArchiveURL  string             `json:"long_archive_url"` // The original link to the campaign's archive version.
+	Status          CampaignStatus     `json:"status"`
+	EmailsSent      int                `json:"emails_sent"`
+	SendTime        string             `json:"send_time"`    // The date and time the campaign was sent.
+	ContentType     string             `json:"content_type"` // How the campaign's content is put together, e.g. template or html.
+	Recipients      CampaignRecipients `json:"recipients"`
+	Settings        CampaignSettings   `json:"settings"`
+	VariateSettings *VariateSettings   `json:"variate_settings,omitempty"` // Only set for variate campaigns.
+	Tracking        CampaignTracking   `json:"tracking"`
+	RSSOpts         *RSSOptions        `json:"rss_opts,omitempty"` // Only set for RSS campaigns.
+	SocialCard      *SocialCard        `json:"social_card,omitempty"`
+}
+
+// CreateCampaignParams - see https://developer.mailchimp.com/documentation/mailchimp/reference/campaigns/#create-post_campaigns
+type CreateCampaignParams struct {
+	Type            CampaignType       `json:"type"` // Defaults to regular.
+	Recipients      CampaignRecipients `json:"recipients"`
+	Settings        CampaignSettings   `json:"settings"`
+	VariateSettings *VariateSettings   `json:"variate_settings,omitempty"` // Required for variate campaigns.
+	Tracking        *CampaignTracking  `json:"tracking,omitempty"`         // Mailchimp tracks opens and clicks if not set.
+	RSSOpts         *RSSOptions        `json:"rss_opts,omitempty"`         // Required for RSS campaigns.
+	SocialCard      *SocialCard        `json:"social_card,omitempty"`
+}
+
+// UpdateCampaignParams - see https://developer.mailchimp.com/documentation/mailchimp/reference/campaigns/#edit-patch_campaigns_campaign_id
+// Only non empty fields are sent, so fields left empty are not changed.
+type UpdateCampaignParams struct {
+	Recipients      *CampaignRecipients `json:"recipients,omitempty"`
+	Settings        *CampaignSettings   `json:"settings,omitempty"`
+	VariateSettings *VariateSettings    `json:"variate_settings,omitempty"`
+	Tracking        *CampaignTracking   `json:"tracking,omitempty"`
+	RSSOpts         *RSSOptions         `json:"rss_opts,omitempty"`
+	SocialCard      *SocialCard         `json:"social_card,omitempty"`
+}
+
+// ListCampaignsParams ...
+type ListCampaignsParams struct {
+	PaginationParams
+	Type             CampaignType
+	Status           CampaignStatus
+	BeforeSendTime   time.Time // Restrict the response to campaigns sent before the set time.
+	SinceSendTime    time.Time // Restrict the response to campaigns sent after the set time.
+	BeforeCreateTime time.Time // Restrict the response to campaigns created before the set time.
+	SinceCreateTime  time.Time // Restrict the response to campaigns created after the set time.
+	ListID           string
+	FolderID         string
+	SortField        string // Either create_time or send_time.
+	SortDir          string // Either ASC or DESC.
+}
+
+// ListCampaignsResponse ...
+type ListCampaignsResponse struct {
+	Campaigns  []Campaign `json:"campaigns"`
+	TotalItems int        `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// CreateCampaign creates a new campaign. The type defaults to CampaignRegular.
+func (c *Client) CreateCampaign(params *CreateCampaignParams) (*Campaign, error) {
+	var createCampaignParams CreateCampaignParams
+	if params != nil {
+		createCampaignParams = *params
+	}
+	if createCampaignParams.Type == "" {
+		createCampaignParams.Type = CampaignRegular
+	}
+	campaign := new(Campaign)
+	err := c.request(
+		"POST",
+		"/campaigns",
+		&createCampaignParams,
+		campaign,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// ListCampaigns returns a page of the campaigns in the account
+func (c *Client) ListCampaigns(params *ListCampaignsParams) (*ListCampaignsResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+		if params.Type != "" {
+			query.Set("type", string(params.Type))
+		}
+		if params.Status != "" {
+			query.Set("status", string(params.Status))
+		}
+		setTime(query, "before_send_time", params.BeforeSendTime)
+		setTime(query, "since_send_time", params.SinceSendTime)
+		setTime(query, "before_create_time", params.BeforeCreateTime)
+		setTime(query, "since_create_time", params.SinceCreateTime)
+		if params.ListID != "" {
+			query.Set("list_id", params.ListID)
+		}
+		if params.FolderID != "" {
+			query.Set("folder_id", params.FolderID)
+		}
+		if params.SortField != "" {
+			query.Set("sort_field", params.SortField)
+		}
+		if params.SortDir != "" {
+			query.Set("sort_dir", params.SortDir)
+		}
+	}
+	listCampaignsResponse := new(ListCampaignsResponse)
+	err := c.request(
+		"GET",
+		withQuery("/campaigns", query),
+		nil,
+		listCampaignsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listCampaignsResponse, nil
+}
+
+// GetCampaign returns information about a specific campaign
+func (c *Client) GetCampaign(campaignID string) (*Campaign, error) {
+	campaign := new(Campaign)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/campaigns/%s", campaignID),
+		nil,
+		campaign,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// UpdateCampaign updates the settings or recipients of a campaign which has
+// not been sent yet
+func (c *Client) UpdateCampaign(campaignID string, params *UpdateCampaignParams) (*Campaign, error) {
+	campaign := new(Campaign)
+	err := c.request(
+		"PATCH",
+		fmt.Sprintf("/campaigns/%s", campaignID),
+		params,
+		campaign,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// DeleteCampaign deletes a campaign. Campaigns which have been sent cannot be deleted.
+func (c *Client) DeleteCampaign(campaignID string) error {
+	return c.request(
+		"DELETE",
+		fmt.Sprintf("/campaigns/%s", campaignID),
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,370 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCampaign(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/campaigns", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, "regular", params["type"])
+		assert.Equal(t, map[string]interface{}{"list_id": "list_id"}, params["recipients"])
+		assert.Equal(t, map[string]interface{}{
+			"subject_line": "April news",
+			"from_name":    "Harold Finch",
+			"reply_to":     "harold@finch.com",
+			"folder_id":    "f1",
+		}, params["settings"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, campaignResponse)
+	})
+	defer server.Close()
+
+	params := &mailchimp.CreateCampaignParams{
+		Recipients: mailchimp.CampaignRecipients{ListID: "list_id"},
+		Settings: mailchimp.CampaignSettings{
+			SubjectLine: "April news",
+			FromName:    "Harold Finch",
+			ReplyTo:     "harold@finch.com",
+			FolderID:    "f1",
+		},
+	}
+	campaign, err := client.CreateCampaign(params)
+	assert.NoError(t, err)
+	assert.Equal(t, "42694e9e57", campaign.ID)
+	assert.Equal(t, mailchimp.CampaignRegular, campaign.Type)
+	assert.Equal(t, 42, campaign.Recipients.RecipientCount)
+	assert.Equal(t, "April newsletter", campaign.Settings.Title)
+
+	// The caller's params are left untouched
+	assert.Equal(t, mailchimp.CampaignType(""), params.Type)
+}
+
+func TestCreateCampaignNilParams(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/campaigns", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, "regular", params["type"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, campaignResponse)
+	})
+	defer server.Close()
+
+	campaign, err := client.CreateCampaign(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "42694e9e57", campaign.ID)
+}
+
+func TestCreateCampaignSegment(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, "plaintext", params["type"])
+		recipients := params["recipients"].(map[string]interface{})
+		assert.Equal(t, map[string]interface{}{"saved_segment_id": float64(23)}, recipients["segment_opts"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, campaignResponse)
+	})
+	defer server.Close()
+
+	_, err := client.CreateCampaign(&mailchimp.CreateCampaignParams{
+		Type: mailchimp.CampaignPlaintext,
+		Recipients: mailchimp.CampaignRecipients{
+			ListID:      "list_id",
+			SegmentOpts: &mailchimp.CampaignSegmentOptions{SavedSegmentID: 23},
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestCreateCampaignSegmentConditions(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		recipients := params["recipients"].(map[string]interface{})
+		assert.Equal(t, map[string]interface{}{
+			"match": "all",
+			"conditions": []interface{}{
+				map[string]interface{}{"condition_type": "TextMerge", "field": "PLAN", "op": "is", "value": "pro"},
+				map[string]interface{}{"condition_type": "StaticSegment", "field": "static_segment", "op": "static_is", "value": float64(17)},
+			},
+		}, recipients["segment_opts"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"id": "42694e9e57",
+			"recipients": {
+				"list_id": "list_id",
+				"segment_opts": {
+					"match": "all",
+					"conditions": [{"condition_type": "TextMerge", "field": "PLAN", "op": "is", "value": "pro"}]
+				},
+				"segment_text": "Plan is pro",
+				"recipient_count": 12
+			}
+		}`)
+	})
+	defer server.Close()
+
+	campaign, err := client.CreateCampaign(&mailchimp.CreateCampaignParams{
+		Recipients: mailchimp.CampaignRecipients{
+			ListID: "list_id",
+			SegmentOpts: mailchimp.NewCampaignSegmentOptions(
+				mailchimp.NewSegmentOptions(mailchimp.SegmentMatchAll).
+					MergeField("PLAN", mailchimp.OpIs, "pro").
+					StaticSegment(mailchimp.OpStaticIs, 17),
+			),
+		},
+	})
+	assert.NoError(t, err)
+	segmentOpts := campaign.Recipients.SegmentOpts
+	assert.Equal(t, mailchimp.SegmentMatchAll, segmentOpts.Match)
+	assert.Equal(t, mailchimp.ConditionTextMerge, segmentOpts.Conditions[0].ConditionType)
+	assert.Equal(t, "Plan is pro", campaign.Recipients.SegmentText)
+}
+
+func TestDecodeCampaignSavedSegment(t *testing.T) {
+	var recipients mailchimp.CampaignRecipients
+	assert.NoError(t, json.Unmarshal([]byte(`{"list_id": "list_id", "segment_opts": {"saved_segment_id": 5}}`), &recipients))
+	assert.Equal(t, 5, recipients.SegmentOpts.SavedSegmentID)
+	assert.Equal(t, mailchimp.SegmentMatch(""), recipients.SegmentOpts.Match)
+	assert.Empty(t, recipients.SegmentOpts.Conditions)
+}
+
+func TestCreateRSSCampaign(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, "rss", params["type"])
+		assert.Equal(t, map[string]interface{}{
+			"feed_url":  "https://example.com/blog/feed",
+			"frequency": "weekly",
+			"schedule": map[string]interface{}{
+				"hour":            float64(9),
+				"weekly_send_day": "monday",
+			},
+			"constrain_rss_img": true,
+		}, params["rss_opts"])
+		assert.Equal(t, map[string]interface{}{
+			"image_url": "https://example.com/digest.png",
+			"title":     "Weekly digest",
+		}, params["social_card"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"id": "42694e9e57",
+			"type": "rss",
+			"rss_opts": {
+				"feed_url": "https://example.com/blog/feed",
+				"frequency": "weekly",
+				"schedule": {"hour": 9, "weekly_send_day": "monday"},
+				"last_sent": "",
+				"constrain_rss_img": true
+			},
+			"social_card": {"image_url": "https://example.com/digest.png", "description": "", "title": "Weekly digest"}
+		}`)
+	})
+	defer server.Close()
+
+	campaign, err := client.CreateCampaign(&mailchimp.CreateCampaignParams{
+		Type:       mailchimp.CampaignRSS,
+		Recipients: mailchimp.CampaignRecipients{ListID: "list_id"},
+		RSSOpts: &mailchimp.RSSOptions{
+			FeedURL:         "https://example.com/blog/feed",
+			Frequency:       mailchimp.RSSWeekly,
+			Schedule:        mailchimp.RSSSchedule{Hour: 9, WeeklySendDay: "monday"},
+			ConstrainRSSImg: true,
+		},
+		SocialCard: &mailchimp.SocialCard{
+			ImageURL: "https://example.com/digest.png",
+			Title:    "Weekly digest",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, mailchimp.RSSWeekly, campaign.RSSOpts.Frequency)
+	assert.Equal(t, 9, campaign.RSSOpts.Schedule.Hour)
+	assert.Equal(t, "Weekly digest", campaign.SocialCard.Title)
+}
+
+func TestCreateVariateCampaign(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, "variate", params["type"])
+		assert.Equal(t, map[string]interface{}{
+			"winner_criteria": "opens",
+			"wait_time":       float64(240),
+			"test_size":       float64(20),
+			"subject_lines":   []interface{}{"April news", "What we shipped in April"},
+		}, params["variate_settings"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"id": "42694e9e57",
+			"type": "variate",
+			"variate_settings": {
+				"winning_combination_id": "",
+				"winning_campaign_id": "",
+				"winner_criteria": "opens",
+				"wait_time": 240,
+				"test_size": 20,
+				"subject_lines": ["April news", "What we shipped in April"],
+				"send_times": [],
+				"from_names": [],
+				"reply_to_addresses": [],
+				"contents": ["Content 1"],
+				"combinations": [
+					{"id": "c1", "subject_line": 0, "send_time": 0, "from_name": 0, "reply_to": 0, "content_description": 0, "recipients": 4},
+					{"id": "c2", "subject_line": 1, "send_time": 0, "from_name": 0, "reply_to": 0, "content_description": 0, "recipients": 4}
+				]
+			}
+		}`)
+	})
+	defer server.Close()
+
+	campaign, err := client.CreateCampaign(&mailchimp.CreateCampaignParams{
+		Type:       mailchimp.CampaignVariate,
+		Recipients: mailchimp.CampaignRecipients{ListID: "list_id"},
+		VariateSettings: &mailchimp.VariateSettings{
+			WinnerCriteria: mailchimp.WinnerOpens,
+			WaitTime:       240,
+			TestSize:       20,
+			SubjectLines:   []string{"April news", "What we shipped in April"},
+		},
+	})
+	assert.NoError(t, err)
+	combinations := campaign.VariateSettings.Combinations
+	assert.Len(t, combinations, 2)
+	assert.Equal(t, "What we shipped in April", campaign.VariateSettings.SubjectLines[combinations[1].SubjectLine])
+	assert.Equal(t, 4, combinations[1].Recipients)
+}
+
+func TestListCampaigns(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/campaigns", req.URL.Path)
+		assert.Equal(
+			t,
+			"count=50&list_id=list_id&since_send_time=2019-04-01T00%3A00%3A00%2B00%3A00&sort_dir=DESC&sort_field=send_time&status=sent&type=regular",
+			req.URL.RawQuery,
+		)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"campaigns": [%s], "total_items": 1}`, campaignResponse)
+	})
+	defer server.Close()
+
+	listCampaignsResponse, err := client.ListCampaigns(&mailchimp.ListCampaignsParams{
+		PaginationParams: mailchimp.PaginationParams{Count: 50},
+		Type:             mailchimp.CampaignRegular,
+		Status:           mailchimp.CampaignSent,
+		SinceSendTime:    time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC),
+		ListID:           "list_id",
+		SortField:        "send_time",
+		SortDir:          "DESC",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, listCampaignsResponse.TotalItems)
+	assert.Equal(t, mailchimp.CampaignSave, listCampaignsResponse.Campaigns[0].Status)
+}
+
+func TestGetCampaign(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, campaignResponse)
+	})
+	defer server.Close()
+
+	campaign, err := client.GetCampaign("42694e9e57")
+	assert.NoError(t, err)
+	assert.Equal(t, "April news", campaign.Settings.SubjectLine)
+	assert.Equal(t, "list_id", campaign.Recipients.ListID)
+}
+
+func TestUpdateCampaign(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"settings": map[string]interface{}{"subject_line": "April news (fixed)"},
+		}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, campaignResponse)
+	})
+	defer server.Close()
+
+	_, err := client.UpdateCampaign("42694e9e57", &mailchimp.UpdateCampaignParams{
+		Settings: &mailchimp.CampaignSettings{SubjectLine: "April news (fixed)"},
+	})
+	assert.NoError(t, err)
+}
+
+func TestUpdateCampaignTracking(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PATCH", req.Method)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"opens":            true,
+			"html_clicks":      true,
+			"text_clicks":      true,
+			"goal_tracking":    false,
+			"ecomm360":         false,
+			"google_analytics": "april_news",
+			"clicktale":        "",
+		}, params["tracking"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, campaignResponse)
+	})
+	defer server.Close()
+
+	campaign, err := client.UpdateCampaign("42694e9e57", &mailchimp.UpdateCampaignParams{
+		Tracking: &mailchimp.CampaignTracking{
+			Opens:           true,
+			HTMLClicks:      true,
+			TextClicks:      true,
+			GoogleAnalytics: "april_news",
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, campaign.Tracking.Opens)
+	assert.Equal(t, "april_news", campaign.Tracking.GoogleAnalytics)
+}
+
+func TestDeleteCampaign(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/campaigns/42694e9e57", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.DeleteCampaign("42694e9e57"))
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
@@ -17,6 +18,8 @@ type Client struct {
 	baseURL *url.URL
 	dc      string
 	apiKey  string
+
+	emailValidation *EmailValidation
 }
 
 // NewClient returns a new Mailchimp API client.  If a nil httpClient is
@@ -72,6 +75,38 @@ func (c *Client) do(method string, path string, body interface{}) (*http.Respons
 	return c.client.Do(req)
 }
 
+// request calls the API and unmarshals a successful (2xx) response body into v.
+// A failed request is returned as an *ErrorResponse.
+func (c *Client) request(method string, path string, body interface{}, v interface{}) error {
+	resp, err := c.do(method, path, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Read the response body
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	// Allow any success status (2xx)
+	if resp.StatusCode/100 == 2 {
+		// Some endpoints (e.g. DELETE) respond with 204 No Content
+		if v == nil || len(data) == 0 {
+			return nil
+		}
+		return json.Unmarshal(data, v)
+	}
+
+	// Request failed
+	errorResponse, err := extractError(data)
+	if err != nil {
+		return err
+	}
+	return errorResponse
+}
+
 func extractError(data []byte) (*ErrorResponse, error) {
 	errorResponse := new(ErrorResponse)
 	if err := json.Unmarshal(data, errorResponse); err != nil {
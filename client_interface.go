@@ -1,16 +1,180 @@
 package mailchimp
 
 import (
+	"io"
 	"net/url"
+	"time"
+
+	"github.com/RichardKnop/go-mailchimp/status"
 )
 
 // ClientInterface defines exported methods
 type ClientInterface interface {
 	// Exported methods
 	CheckSubscription(listID string, email string) (*MemberResponse, error)
+	GetMember(listID string, email string) (*MemberResponse, error)
+	ListMembers(listID string, params *ListMembersParams) (*ListMembersResponse, error)
+	ListArchivedMembers(listID string, params *PaginationParams) (*ListMembersResponse, error)
+	ListCleanedMembers(listID string, params *PaginationParams) (*ListMembersResponse, error)
 	Subscribe(listID string, email string, mergeFields map[string]interface{}) (*MemberResponse, error)
+	SubscribeWithOptions(listID string, email string, params *SubscribeParams) (*MemberResponse, error)
+	SubscribePending(listID string, email string, mergeFields map[string]interface{}) (*MemberResponse, error)
 	UpdateSubscription(listID string, email string, mergeFields map[string]interface{}) (*MemberResponse, error)
 	RemoveSubscription(listID string, email string, mergeFields map[string]interface{}) (*MemberResponse, error)
+	Unsubscribe(listID string, email string) (*MemberResponse, error)
+	UpdateMember(listID string, email string, params *UpdateMemberParams) (*MemberResponse, error)
+	SetMemberVIP(listID string, email string, vip bool) (*MemberResponse, error)
+	AddOrUpdateMember(listID string, email string, params *AddOrUpdateMemberParams) (*MemberResponse, error)
+	Resubscribe(listID string, email string, mergeFields map[string]interface{}) (*MemberResponse, error)
+	ChangeMemberEmail(listID string, email string, newEmail string) (*MemberResponse, error)
+	DeleteMember(listID string, email string) error
+	PermanentDeleteMember(listID string, email string) error
+	UnarchiveMember(listID string, email string, memberStatus status.MemberStatus) (*MemberResponse, error)
+	UpdateMemberTags(listID string, email string, tags []MemberTagUpdate) error
+	GetMemberTags(listID string, email string) (*MemberTagsResponse, error)
+	ListMembersByTag(listID string, tagName string) ([]MemberResponse, error)
+	TagMembers(listID string, tagName string, emails []string) (*SegmentBatchResponse, error)
+	SearchTags(listID string, name string) (*TagSearchResponse, error)
+	CreateMemberNote(listID string, email string, note string) (*MemberNote, error)
+	ListMemberNotes(listID string, email string, params *PaginationParams) (*MemberNotesResponse, error)
+	GetMemberNote(listID string, email string, noteID int) (*MemberNote, error)
+	UpdateMemberNote(listID string, email string, noteID int, note string) (*MemberNote, error)
+	DeleteMemberNote(listID string, email string, noteID int) error
+	CreateMemberEvent(listID string, email string, name string, properties map[string]string) error
+	ListMemberEvents(listID string, email string, params *PaginationParams) (*MemberEventsResponse, error)
+	GetMemberActivityFeed(listID string, email string, params *MemberActivityParams) (*MemberActivityResponse, error)
+	GetMemberGoals(listID string, email string) (*MemberGoalsResponse, error)
+	GetListMarketingPermissions(listID string) ([]MarketingPermission, error)
+	SearchMembers(query string, listID string) (*SearchMembersResponse, error)
+	BulkSubscribe(listID string, members []BatchMember, updateExisting bool) (*BatchSubscribeResponse, error)
+	BulkSubscribeWithOptions(listID string, members []BatchMember, options *BulkSubscribeOptions) (*BatchSubscribeResponse, error)
+	UnsubscribeMany(listID string, emails []string) (*BatchSubscribeResponse, error)
+	ListSegments(listID string, params *ListSegmentsParams) (*ListSegmentsResponse, error)
+	GetSegment(listID string, segmentID int) (*Segment, error)
+	CreateSegment(listID string, params *SegmentParams) (*Segment, error)
+	UpdateSegment(listID string, segmentID int, params *SegmentParams) (*Segment, error)
+	DeleteSegment(listID string, segmentID int) error
+	ListSegmentMembers(listID string, segmentID int, params *PaginationParams) (*ListMembersResponse, error)
+	AddSegmentMember(listID string, segmentID int, email string) (*MemberResponse, error)
+	RemoveSegmentMember(listID string, segmentID int, email string) error
+	BatchSegmentMembers(listID string, segmentID int, membersToAdd []string, membersToRemove []string) (*SegmentBatchResponse, error)
+	CreateList(params *CreateListParams) (*ListResponse, error)
+	GetList(listID string, params *FieldsParams) (*ListResponse, error)
+	GetListStats(listID string) (*ListStats, error)
+	CloneList(listID string, name string) (*ListResponse, error)
+	ListLists(params *ListListsParams) (*ListListsResponse, error)
+	UpdateList(listID string, params *UpdateListParams) (*ListResponse, error)
+	DeleteList(listID string, confirm bool) error
+	ListMergeFields(listID string, params *PaginationParams) (*ListMergeFieldsResponse, error)
+	GetMergeField(listID string, mergeID int) (*MergeField, error)
+	CreateMergeField(listID string, params *MergeFieldParams) (*MergeField, error)
+	UpdateMergeField(listID string, mergeID int, params *MergeFieldParams) (*MergeField, error)
+	DeleteMergeField(listID string, mergeID int) error
+	ListInterestCategories(listID string, params *ListInterestCategoriesParams) (*ListInterestCategoriesResponse, error)
+	GetInterestCategory(listID string, categoryID string) (*InterestCategory, error)
+	CreateInterestCategory(listID string, params *InterestCategoryParams) (*InterestCategory, error)
+	UpdateInterestCategory(listID string, categoryID string, params *InterestCategoryParams) (*InterestCategory, error)
+	DeleteInterestCategory(listID string, categoryID string) error
+	ListInterests(listID string, categoryID string, params *PaginationParams) (*ListInterestsResponse, error)
+	GetInterest(listID string, categoryID string, interestID string) (*Interest, error)
+	CreateInterest(listID string, categoryID string, params *InterestParams) (*Interest, error)
+	UpdateInterest(listID string, categoryID string, interestID string, params *InterestParams) (*Interest, error)
+	DeleteInterest(listID string, categoryID string, interestID string) error
+	EnsureInterest(listID string, categoryID string, name string) (string, error)
+	ListWebhooks(listID string) (*ListWebhooksResponse, error)
+	GetWebhook(listID string, webhookID string) (*Webhook, error)
+	CreateWebhook(listID string, params *WebhookParams) (*Webhook, error)
+	UpdateWebhook(listID string, webhookID string, params *WebhookParams) (*Webhook, error)
+	DeleteWebhook(listID string, webhookID string) error
+	ListSignupForms(listID string) (*ListSignupFormsResponse, error)
+	CustomizeSignupForm(listID string, params *SignupFormParams) (*SignupForm, error)
+	GetGrowthHistory(listID string, params *GrowthHistoryParams) (*GrowthHistoryResponse, error)
+	GetGrowthHistoryMonth(listID string, month string) (*GrowthHistory, error)
+	GetListActivity(listID string) (*ListActivityResponse, error)
+	ListAbuseReports(listID string, params *PaginationParams) (*ListAbuseReportsResponse, error)
+	GetAbuseReport(listID string, reportID int) (*AbuseReport, error)
+	GetListClients(listID string) (*ListClientsResponse, error)
+	GetListLocations(listID string) (*ListLocationsResponse, error)
+	ListSurveys(listID string) (*ListSurveysResponse, error)
+	GetSurvey(listID string, surveyID string) (*Survey, error)
+	PublishSurvey(listID string, surveyID string) (*Survey, error)
+	UnpublishSurvey(listID string, surveyID string) (*Survey, error)
+	ListSurveyResponses(surveyID string, params *SurveyResponsesParams) (*ListSurveyResponsesResponse, error)
+	GetSurveyResponse(surveyID string, responseID string) (*SurveyResponse, error)
+	CreateCampaign(params *CreateCampaignParams) (*Campaign, error)
+	ListCampaigns(params *ListCampaignsParams) (*ListCampaignsResponse, error)
+	GetCampaign(campaignID string) (*Campaign, error)
+	UpdateCampaign(campaignID string, params *UpdateCampaignParams) (*Campaign, error)
+	DeleteCampaign(campaignID string) error
+	SetCampaignContent(campaignID string, params *CampaignContentParams) (*CampaignContent, error)
+	GetCampaignContent(campaignID string) (*CampaignContent, error)
+	SendCampaign(campaignID string) error
+	ScheduleCampaign(campaignID string, scheduleTime time.Time, options *ScheduleOptions) error
+	UnscheduleCampaign(campaignID string) error
+	SendTestEmail(campaignID string, emails []string, sendType string) error
+	GetSendChecklist(campaignID string) (*SendChecklist, error)
+	PauseCampaign(campaignID string) error
+	ResumeCampaign(campaignID string) error
+	ReplicateCampaign(campaignID string) (*Campaign, error)
+	CancelSend(campaignID string) error
+	CreateResend(campaignID string, shortcutType string) (*Campaign, error)
+	SearchCampaigns(query string) (*SearchCampaignsResponse, error)
+	ListCampaignFeedback(campaignID string) (*ListCampaignFeedbackResponse, error)
+	GetCampaignFeedback(campaignID string, feedbackID int) (*CampaignFeedback, error)
+	CreateCampaignFeedback(campaignID string, params *CampaignFeedbackParams) (*CampaignFeedback, error)
+	UpdateCampaignFeedback(campaignID string, feedbackID int, params *CampaignFeedbackParams) (*CampaignFeedback, error)
+	DeleteCampaignFeedback(campaignID string, feedbackID int) error
+	ListCampaignFolders(params *PaginationParams) (*ListCampaignFoldersResponse, error)
+	GetCampaignFolder(folderID string) (*Folder, error)
+	CreateCampaignFolder(name string) (*Folder, error)
+	UpdateCampaignFolder(folderID string, name string) (*Folder, error)
+	DeleteCampaignFolder(folderID string) error
+	ListTemplates(params *ListTemplatesParams) (*ListTemplatesResponse, error)
+	GetTemplate(templateID int) (*Template, error)
+	CreateTemplate(params *TemplateParams) (*Template, error)
+	CreateTemplateFromReader(name string, r io.Reader, folderID string) (*Template, error)
+	CreateTemplateFromFile(name string, path string, folderID string) (*Template, error)
+	UpdateTemplate(templateID int, params *TemplateParams) (*Template, error)
+	DeleteTemplate(templateID int) error
+	GetTemplateDefaultContent(templateID int) (*TemplateDefaultContent, error)
+	ListTemplateFolders(params *PaginationParams) (*ListTemplateFoldersResponse, error)
+	GetTemplateFolder(folderID string) (*Folder, error)
+	CreateTemplateFolder(name string) (*Folder, error)
+	UpdateTemplateFolder(folderID string, name string) (*Folder, error)
+	DeleteTemplateFolder(folderID string) error
+	GetReport(campaignID string) (*Report, error)
+	GetSubReports(campaignID string) (*SubReportsResponse, error)
+	GetClickDetails(campaignID string, params *PaginationParams) (*ClickDetailsResponse, error)
+	GetClickDetailsMembers(campaignID string, linkID string, params *PaginationParams) (*ClickDetailsMembersResponse, error)
+	GetOpenDetails(campaignID string, params *OpenDetailsParams) (*OpenDetailsResponse, error)
+	GetEmailActivity(campaignID string, params *EmailActivityParams) (*EmailActivityResponse, error)
+	GetMemberEmailActivity(campaignID string, email string, since time.Time) (*EmailActivity, error)
+	GetUnsubscribed(campaignID string, params *PaginationParams) (*UnsubscribedResponse, error)
+	GetSentTo(campaignID string, params *PaginationParams) (*SentToResponse, error)
+	GetDomainPerformance(campaignID string) (*DomainPerformanceResponse, error)
+	GetEepurlReport(campaignID string) (*EepurlReport, error)
+	GetReportLocations(campaignID string, params *PaginationParams) (*ReportLocationsResponse, error)
+	GetAdvice(campaignID string) (*AdviceResponse, error)
+	GetCampaignAbuseReports(campaignID string) (*CampaignAbuseReportsResponse, error)
+	GetCampaignAbuseReport(campaignID string, reportID int) (*AbuseReport, error)
+	ExportEmailActivityCSV(campaignID string, w io.Writer) error
+	ExportSentToCSV(campaignID string, w io.Writer) error
+	ExportOpenDetailsCSV(campaignID string, w io.Writer) error
+	ListFacebookAds(params *PaginationParams) (*ListFacebookAdsResponse, error)
+	GetFacebookAd(outreachID string) (*FacebookAd, error)
+	GetFacebookAdReport(outreachID string) (*FacebookAdReport, error)
+	CreateLandingPage(params *LandingPageParams) (*LandingPage, error)
+	GetLandingPage(pageID string) (*LandingPage, error)
+	UpdateLandingPage(pageID string, params *LandingPageParams) (*LandingPage, error)
+	DeleteLandingPage(pageID string) error
+	PublishLandingPage(pageID string) error
+	UnpublishLandingPage(pageID string) error
+	GetLandingPageContent(pageID string) (*LandingPageContent, error)
+	ListLandingPageReports(params *PaginationParams) (*ListLandingPageReportsResponse, error)
+	GetLandingPageReport(pageID string) (*LandingPageReport, error)
+	ListAutomations(params *ListAutomationsParams) (*ListAutomationsResponse, error)
+	GetAutomation(workflowID string) (*Automation, error)
+	SetEmailValidation(emailValidation *EmailValidation)
 	SetBaseURL(baseURL *url.URL)
 	GetBaseURL() *url.URL
 }
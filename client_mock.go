@@ -1,8 +1,11 @@
 package mailchimp
 
 import (
+	"io"
 	"net/url"
+	"time"
 
+	"github.com/RichardKnop/go-mailchimp/status"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -80,6 +83,3396 @@ func (_m *ClientMock) UpdateSubscription(listID string, email string, mergeField
 	return r0, r1
 }
 
+// Unsubscribe ...
+func (_m *ClientMock) Unsubscribe(listID string, email string) (*MemberResponse, error) {
+	ret := _m.Called(listID, email)
+
+	var r0 *MemberResponse
+	if rf, ok := ret.Get(0).(func(string, string) *MemberResponse); ok {
+		r0 = rf(listID, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(listID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateMember ...
+func (_m *ClientMock) UpdateMember(listID string, email string, params *UpdateMemberParams) (*MemberResponse, error) {
+	ret := _m.Called(listID, email, params)
+
+	var r0 *MemberResponse
+	if rf, ok := ret.Get(0).(func(string, string, *UpdateMemberParams) *MemberResponse); ok {
+		r0 = rf(listID, email, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, *UpdateMemberParams) error); ok {
+		r1 = rf(listID, email, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscribeWithOptions ...
+func (_m *ClientMock) SubscribeWithOptions(listID string, email string, params *SubscribeParams) (*MemberResponse, error) {
+	ret := _m.Called(listID, email, params)
+
+	var r0 *MemberResponse
+	if rf, ok := ret.Get(0).(func(string, string, *SubscribeParams) *MemberResponse); ok {
+		r0 = rf(listID, email, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, *SubscribeParams) error); ok {
+		r1 = rf(listID, email, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscribePending ...
+func (_m *ClientMock) SubscribePending(listID string, email string, mergeFields map[string]interface{}) (*MemberResponse, error) {
+	ret := _m.Called(listID, email, mergeFields)
+
+	var r0 *MemberResponse
+	if rf, ok := ret.Get(0).(func(string, string, map[string]interface{}) *MemberResponse); ok {
+		r0 = rf(listID, email, mergeFields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, map[string]interface{}) error); ok {
+		r1 = rf(listID, email, mergeFields)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMember ...
+func (_m *ClientMock) GetMember(listID string, email string) (*MemberResponse, error) {
+	ret := _m.Called(listID, email)
+
+	var r0 *MemberResponse
+	if rf, ok := ret.Get(0).(func(string, string) *MemberResponse); ok {
+		r0 = rf(listID, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(listID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteMember ...
+func (_m *ClientMock) DeleteMember(listID string, email string) error {
+	ret := _m.Called(listID, email)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(listID, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PermanentDeleteMember ...
+func (_m *ClientMock) PermanentDeleteMember(listID string, email string) error {
+	ret := _m.Called(listID, email)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(listID, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddOrUpdateMember ...
+func (_m *ClientMock) AddOrUpdateMember(listID string, email string, params *AddOrUpdateMemberParams) (*MemberResponse, error) {
+	ret := _m.Called(listID, email, params)
+
+	var r0 *MemberResponse
+	if rf, ok := ret.Get(0).(func(string, string, *AddOrUpdateMemberParams) *MemberResponse); ok {
+		r0 = rf(listID, email, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, *AddOrUpdateMemberParams) error); ok {
+		r1 = rf(listID, email, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMembers ...
+func (_m *ClientMock) ListMembers(listID string, params *ListMembersParams) (*ListMembersResponse, error) {
+	ret := _m.Called(listID, params)
+
+	var r0 *ListMembersResponse
+	if rf, ok := ret.Get(0).(func(string, *ListMembersParams) *ListMembersResponse); ok {
+		r0 = rf(listID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListMembersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *ListMembersParams) error); ok {
+		r1 = rf(listID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateMemberTags ...
+func (_m *ClientMock) UpdateMemberTags(listID string, email string, tags []MemberTagUpdate) error {
+	ret := _m.Called(listID, email, tags)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, []MemberTagUpdate) error); ok {
+		r0 = rf(listID, email, tags)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetMemberTags ...
+func (_m *ClientMock) GetMemberTags(listID string, email string) (*MemberTagsResponse, error) {
+	ret := _m.Called(listID, email)
+
+	var r0 *MemberTagsResponse
+	if rf, ok := ret.Get(0).(func(string, string) *MemberTagsResponse); ok {
+		r0 = rf(listID, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberTagsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(listID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateMemberNote ...
+func (_m *ClientMock) CreateMemberNote(listID string, email string, note string) (*MemberNote, error) {
+	ret := _m.Called(listID, email, note)
+
+	var r0 *MemberNote
+	if rf, ok := ret.Get(0).(func(string, string, string) *MemberNote); ok {
+		r0 = rf(listID, email, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberNote)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(listID, email, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMemberNotes ...
+func (_m *ClientMock) ListMemberNotes(listID string, email string, params *PaginationParams) (*MemberNotesResponse, error) {
+	ret := _m.Called(listID, email, params)
+
+	var r0 *MemberNotesResponse
+	if rf, ok := ret.Get(0).(func(string, string, *PaginationParams) *MemberNotesResponse); ok {
+		r0 = rf(listID, email, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberNotesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, *PaginationParams) error); ok {
+		r1 = rf(listID, email, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMemberNote ...
+func (_m *ClientMock) GetMemberNote(listID string, email string, noteID int) (*MemberNote, error) {
+	ret := _m.Called(listID, email, noteID)
+
+	var r0 *MemberNote
+	if rf, ok := ret.Get(0).(func(string, string, int) *MemberNote); ok {
+		r0 = rf(listID, email, noteID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberNote)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, int) error); ok {
+		r1 = rf(listID, email, noteID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateMemberNote ...
+func (_m *ClientMock) UpdateMemberNote(listID string, email string, noteID int, note string) (*MemberNote, error) {
+	ret := _m.Called(listID, email, noteID, note)
+
+	var r0 *MemberNote
+	if rf, ok := ret.Get(0).(func(string, string, int, string) *MemberNote); ok {
+		r0 = rf(listID, email, noteID, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberNote)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, int, string) error); ok {
+		r1 = rf(listID, email, noteID, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteMemberNote ...
+func (_m *ClientMock) DeleteMemberNote(listID string, email string, noteID int) error {
+	ret := _m.Called(listID, email, noteID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, int) error); ok {
+		r0 = rf(listID, email, noteID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateMemberEvent ...
+func (_m *ClientMock) CreateMemberEvent(listID string, email string, name string, properties map[string]string) error {
+	ret := _m.Called(listID, email, name, properties)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, map[string]string) error); ok {
+		r0 = rf(listID, email, name, properties)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListMemberEvents ...
+func (_m *ClientMock) ListMemberEvents(listID string, email string, params *PaginationParams) (*MemberEventsResponse, error) {
+	ret := _m.Called(listID, email, params)
+
+	var r0 *MemberEventsResponse
+	if rf, ok := ret.Get(0).(func(string, string, *PaginationParams) *MemberEventsResponse); ok {
+		r0 = rf(listID, email, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberEventsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, *PaginationParams) error); ok {
+		r1 = rf(listID, email, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMemberActivityFeed ...
+func (_m *ClientMock) GetMemberActivityFeed(listID string, email string, params *MemberActivityParams) (*MemberActivityResponse, error) {
+	ret := _m.Called(listID, email, params)
+
+	var r0 *MemberActivityResponse
+	if rf, ok := ret.Get(0).(func(string, string, *MemberActivityParams) *MemberActivityResponse); ok {
+		r0 = rf(listID, email, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberActivityResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, *MemberActivityParams) error); ok {
+		r1 = rf(listID, email, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMemberGoals ...
+func (_m *ClientMock) GetMemberGoals(listID string, email string) (*MemberGoalsResponse, error) {
+	ret := _m.Called(listID, email)
+
+	var r0 *MemberGoalsResponse
+	if rf, ok := ret.Get(0).(func(string, string) *MemberGoalsResponse); ok {
+		r0 = rf(listID, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberGoalsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(listID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetListMarketingPermissions ...
+func (_m *ClientMock) GetListMarketingPermissions(listID string) ([]MarketingPermission, error) {
+	ret := _m.Called(listID)
+
+	var r0 []MarketingPermission
+	if rf, ok := ret.Get(0).(func(string) []MarketingPermission); ok {
+		r0 = rf(listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]MarketingPermission)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetMemberVIP ...
+func (_m *ClientMock) SetMemberVIP(listID string, email string, vip bool) (*MemberResponse, error) {
+	ret := _m.Called(listID, email, vip)
+
+	var r0 *MemberResponse
+	if rf, ok := ret.Get(0).(func(string, string, bool) *MemberResponse); ok {
+		r0 = rf(listID, email, vip)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, bool) error); ok {
+		r1 = rf(listID, email, vip)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchMembers ...
+func (_m *ClientMock) SearchMembers(query string, listID string) (*SearchMembersResponse, error) {
+	ret := _m.Called(query, listID)
+
+	var r0 *SearchMembersResponse
+	if rf, ok := ret.Get(0).(func(string, string) *SearchMembersResponse); ok {
+		r0 = rf(query, listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SearchMembersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(query, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BulkSubscribe ...
+func (_m *ClientMock) BulkSubscribe(listID string, members []BatchMember, updateExisting bool) (*BatchSubscribeResponse, error) {
+	ret := _m.Called(listID, members, updateExisting)
+
+	var r0 *BatchSubscribeResponse
+	if rf, ok := ret.Get(0).(func(string, []BatchMember, bool) *BatchSubscribeResponse); ok {
+		r0 = rf(listID, members, updateExisting)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*BatchSubscribeResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, []BatchMember, bool) error); ok {
+		r1 = rf(listID, members, updateExisting)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Resubscribe ...
+func (_m *ClientMock) Resubscribe(listID string, email string, mergeFields map[string]interface{}) (*MemberResponse, error) {
+	ret := _m.Called(listID, email, mergeFields)
+
+	var r0 *MemberResponse
+	if rf, ok := ret.Get(0).(func(string, string, map[string]interface{}) *MemberResponse); ok {
+		r0 = rf(listID, email, mergeFields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, map[string]interface{}) error); ok {
+		r1 = rf(listID, email, mergeFields)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChangeMemberEmail ...
+func (_m *ClientMock) ChangeMemberEmail(listID string, email string, newEmail string) (*MemberResponse, error) {
+	ret := _m.Called(listID, email, newEmail)
+
+	var r0 *MemberResponse
+	if rf, ok := ret.Get(0).(func(string, string, string) *MemberResponse); ok {
+		r0 = rf(listID, email, newEmail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(listID, email, newEmail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnsubscribeMany ...
+func (_m *ClientMock) UnsubscribeMany(listID string, emails []string) (*BatchSubscribeResponse, error) {
+	ret := _m.Called(listID, emails)
+
+	var r0 *BatchSubscribeResponse
+	if rf, ok := ret.Get(0).(func(string, []string) *BatchSubscribeResponse); ok {
+		r0 = rf(listID, emails)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*BatchSubscribeResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, []string) error); ok {
+		r1 = rf(listID, emails)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListArchivedMembers ...
+func (_m *ClientMock) ListArchivedMembers(listID string, params *PaginationParams) (*ListMembersResponse, error) {
+	ret := _m.Called(listID, params)
+
+	var r0 *ListMembersResponse
+	if rf, ok := ret.Get(0).(func(string, *PaginationParams) *ListMembersResponse); ok {
+		r0 = rf(listID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListMembersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *PaginationParams) error); ok {
+		r1 = rf(listID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnarchiveMember ...
+func (_m *ClientMock) UnarchiveMember(listID string, email string, memberStatus status.MemberStatus) (*MemberResponse, error) {
+	ret := _m.Called(listID, email, memberStatus)
+
+	var r0 *MemberResponse
+	if rf, ok := ret.Get(0).(func(string, string, status.MemberStatus) *MemberResponse); ok {
+		r0 = rf(listID, email, memberStatus)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, status.MemberStatus) error); ok {
+		r1 = rf(listID, email, memberStatus)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCleanedMembers ...
+func (_m *ClientMock) ListCleanedMembers(listID string, params *PaginationParams) (*ListMembersResponse, error) {
+	ret := _m.Called(listID, params)
+
+	var r0 *ListMembersResponse
+	if rf, ok := ret.Get(0).(func(string, *PaginationParams) *ListMembersResponse); ok {
+		r0 = rf(listID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListMembersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *PaginationParams) error); ok {
+		r1 = rf(listID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMembersByTag ...
+func (_m *ClientMock) ListMembersByTag(listID string, tagName string) ([]MemberResponse, error) {
+	ret := _m.Called(listID, tagName)
+
+	var r0 []MemberResponse
+	if rf, ok := ret.Get(0).(func(string, string) []MemberResponse); ok {
+		r0 = rf(listID, tagName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]MemberResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(listID, tagName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSegmentMembers ...
+func (_m *ClientMock) ListSegmentMembers(listID string, segmentID int, params *PaginationParams) (*ListMembersResponse, error) {
+	ret := _m.Called(listID, segmentID, params)
+
+	var r0 *ListMembersResponse
+	if rf, ok := ret.Get(0).(func(string, int, *PaginationParams) *ListMembersResponse); ok {
+		r0 = rf(listID, segmentID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListMembersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int, *PaginationParams) error); ok {
+		r1 = rf(listID, segmentID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetEmailValidation ...
+func (_m *ClientMock) SetEmailValidation(emailValidation *EmailValidation) {
+	_m.Called(emailValidation)
+}
+
+// TagMembers ...
+func (_m *ClientMock) TagMembers(listID string, tagName string, emails []string) (*SegmentBatchResponse, error) {
+	ret := _m.Called(listID, tagName, emails)
+
+	var r0 *SegmentBatchResponse
+	if rf, ok := ret.Get(0).(func(string, string, []string) *SegmentBatchResponse); ok {
+		r0 = rf(listID, tagName, emails)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SegmentBatchResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, []string) error); ok {
+		r1 = rf(listID, tagName, emails)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateList ...
+func (_m *ClientMock) CreateList(params *CreateListParams) (*ListResponse, error) {
+	ret := _m.Called(params)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(*CreateListParams) *ListResponse); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*CreateListParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetList ...
+func (_m *ClientMock) GetList(listID string, params *FieldsParams) (*ListResponse, error) {
+	ret := _m.Called(listID, params)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(string, *FieldsParams) *ListResponse); ok {
+		r0 = rf(listID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *FieldsParams) error); ok {
+		r1 = rf(listID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListLists ...
+func (_m *ClientMock) ListLists(params *ListListsParams) (*ListListsResponse, error) {
+	ret := _m.Called(params)
+
+	var r0 *ListListsResponse
+	if rf, ok := ret.Get(0).(func(*ListListsParams) *ListListsResponse); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListListsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ListListsParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateList ...
+func (_m *ClientMock) UpdateList(listID string, params *UpdateListParams) (*ListResponse, error) {
+	ret := _m.Called(listID, params)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(string, *UpdateListParams) *ListResponse); ok {
+		r0 = rf(listID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *UpdateListParams) error); ok {
+		r1 = rf(listID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteList ...
+func (_m *ClientMock) DeleteList(listID string, confirm bool) error {
+	ret := _m.Called(listID, confirm)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, bool) error); ok {
+		r0 = rf(listID, confirm)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListMergeFields ...
+func (_m *ClientMock) ListMergeFields(listID string, params *PaginationParams) (*ListMergeFieldsResponse, error) {
+	ret := _m.Called(listID, params)
+
+	var r0 *ListMergeFieldsResponse
+	if rf, ok := ret.Get(0).(func(string, *PaginationParams) *ListMergeFieldsResponse); ok {
+		r0 = rf(listID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListMergeFieldsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *PaginationParams) error); ok {
+		r1 = rf(listID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMergeField ...
+func (_m *ClientMock) GetMergeField(listID string, mergeID int) (*MergeField, error) {
+	ret := _m.Called(listID, mergeID)
+
+	var r0 *MergeField
+	if rf, ok := ret.Get(0).(func(string, int) *MergeField); ok {
+		r0 = rf(listID, mergeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MergeField)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(listID, mergeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateMergeField ...
+func (_m *ClientMock) CreateMergeField(listID string, params *MergeFieldParams) (*MergeField, error) {
+	ret := _m.Called(listID, params)
+
+	var r0 *MergeField
+	if rf, ok := ret.Get(0).(func(string, *MergeFieldParams) *MergeField); ok {
+		r0 = rf(listID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MergeField)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *MergeFieldParams) error); ok {
+		r1 = rf(listID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateMergeField ...
+func (_m *ClientMock) UpdateMergeField(listID string, mergeID int, params *MergeFieldParams) (*MergeField, error) {
+	ret := _m.Called(listID, mergeID, params)
+
+	var r0 *MergeField
+	if rf, ok := ret.Get(0).(func(string, int, *MergeFieldParams) *MergeField); ok {
+		r0 = rf(listID, mergeID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MergeField)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int, *MergeFieldParams) error); ok {
+		r1 = rf(listID, mergeID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteMergeField ...
+func (_m *ClientMock) DeleteMergeField(listID string, mergeID int) error {
+	ret := _m.Called(listID, mergeID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int) error); ok {
+		r0 = rf(listID, mergeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListInterestCategories ...
+func (_m *ClientMock) ListInterestCategories(listID string, params *ListInterestCategoriesParams) (*ListInterestCategoriesResponse, error) {
+	ret := _m.Called(listID, params)
+
+	var r0 *ListInterestCategoriesResponse
+	if rf, ok := ret.Get(0).(func(string, *ListInterestCategoriesParams) *ListInterestCategoriesResponse); ok {
+		r0 = rf(listID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListInterestCategoriesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *ListInterestCategoriesParams) error); ok {
+		r1 = rf(listID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInterestCategory ...
+func (_m *ClientMock) GetInterestCategory(listID string, categoryID string) (*InterestCategory, error) {
+	ret := _m.Called(listID, categoryID)
+
+	var r0 *InterestCategory
+	if rf, ok := ret.Get(0).(func(string, string) *InterestCategory); ok {
+		r0 = rf(listID, categoryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*InterestCategory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(listID, categoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateInterestCategory ...
+func (_m *ClientMock) CreateInterestCategory(listID string, params *InterestCategoryParams) (*InterestCategory, error) {
+	ret := _m.Called(listID, params)
+
+	var r0 *InterestCategory
+	if rf, ok := ret.Get(0).(func(string, *InterestCategoryParams) *InterestCategory); ok {
+		r0 = rf(listID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*InterestCategory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *InterestCategoryParams) error); ok {
+		r1 = rf(listID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateInterestCategory ...
+func (_m *ClientMock) UpdateInterestCategory(listID string, categoryID string, params *InterestCategoryParams) (*InterestCategory, error) {
+	ret := _m.Called(listID, categoryID, params)
+
+	var r0 *InterestCategory
+	if rf, ok := ret.Get(0).(func(string, string, *InterestCategoryParams) *InterestCategory); ok {
+		r0 = rf(listID, categoryID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*InterestCategory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, *InterestCategoryParams) error); ok {
+		r1 = rf(listID, categoryID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteInterestCategory ...
+func (_m *ClientMock) DeleteInterestCategory(listID string, categoryID string) error {
+	ret := _m.Called(listID, categoryID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(listID, categoryID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListInterests ...
+func (_m *ClientMock) ListInterests(listID string, categoryID string, params *PaginationParams) (*ListInterestsResponse, error) {
+	ret := _m.Called(listID, categoryID, params)
+
+	var r0 *ListInterestsResponse
+	if rf, ok := ret.Get(0).(func(string, string, *PaginationParams) *ListInterestsResponse); ok {
+		r0 = rf(listID, categoryID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListInterestsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, *PaginationParams) error); ok {
+		r1 = rf(listID, categoryID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInterest ...
+func (_m *ClientMock) GetInterest(listID string, categoryID string, interestID string) (*Interest, error) {
+	ret := _m.Called(listID, categoryID, interestID)
+
+	var r0 *Interest
+	if rf, ok := ret.Get(0).(func(string, string, string) *Interest); ok {
+		r0 = rf(listID, categoryID, interestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Interest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(listID, categoryID, interestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateInterest ...
+func (_m *ClientMock) CreateInterest(listID string, categoryID string, params *InterestParams) (*Interest, error) {
+	ret := _m.Called(listID, categoryID, params)
+
+	var r0 *Interest
+	if rf, ok := ret.Get(0).(func(string, string, *InterestParams) *Interest); ok {
+		r0 = rf(listID, categoryID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Interest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, *InterestParams) error); ok {
+		r1 = rf(listID, categoryID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateInterest ...
+func (_m *ClientMock) UpdateInterest(listID string, categoryID string, interestID string, params *InterestParams) (*Interest, error) {
+	ret := _m.Called(listID, categoryID, interestID, params)
+
+	var r0 *Interest
+	if rf, ok := ret.Get(0).(func(string, string, string, *InterestParams) *Interest); ok {
+		r0 = rf(listID, categoryID, interestID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Interest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, *InterestParams) error); ok {
+		r1 = rf(listID, categoryID, interestID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteInterest ...
+func (_m *ClientMock) DeleteInterest(listID string, categoryID string, interestID string) error {
+	ret := _m.Called(listID, categoryID, interestID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(listID, categoryID, interestID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnsureInterest ...
+func (_m *ClientMock) EnsureInterest(listID string, categoryID string, name string) (string, error) {
+	ret := _m.Called(listID, categoryID, name)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = rf(listID, categoryID, name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(listID, categoryID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSegments ...
+func (_m *ClientMock) ListSegments(listID string, params *ListSegmentsParams) (*ListSegmentsResponse, error) {
+	ret := _m.Called(listID, params)
+
+	var r0 *ListSegmentsResponse
+	if rf, ok := ret.Get(0).(func(string, *ListSegmentsParams) *ListSegmentsResponse); ok {
+		r0 = rf(listID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListSegmentsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *ListSegmentsParams) error); ok {
+		r1 = rf(listID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSegment ...
+func (_m *ClientMock) GetSegment(listID string, segmentID int) (*Segment, error) {
+	ret := _m.Called(listID, segmentID)
+
+	var r0 *Segment
+	if rf, ok := ret.Get(0).(func(string, int) *Segment); ok {
+		r0 = rf(listID, segmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Segment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(listID, segmentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateSegment ...
+func (_m *ClientMock) CreateSegment(listID string, params *SegmentParams) (*Segment, error) {
+	ret := _m.Called(listID, params)
+
+	var r0 *Segment
+	if rf, ok := ret.Get(0).(func(string, *SegmentParams) *Segment); ok {
+		r0 = rf(listID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Segment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *SegmentParams) error); ok {
+		r1 = rf(listID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateSegment ...
+func (_m *ClientMock) UpdateSegment(listID string, segmentID int, params *SegmentParams) (*Segment, error) {
+	ret := _m.Called(listID, segmentID, params)
+
+	var r0 *Segment
+	if rf, ok := ret.Get(0).(func(string, int, *SegmentParams) *Segment); ok {
+		r0 = rf(listID, segmentID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Segment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int, *SegmentParams) error); ok {
+		r1 = rf(listID, segmentID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteSegment ...
+func (_m *ClientMock) DeleteSegment(listID string, segmentID int) error {
+	ret := _m.Called(listID, segmentID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int) error); ok {
+		r0 = rf(listID, segmentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddSegmentMember ...
+func (_m *ClientMock) AddSegmentMember(listID string, segmentID int, email string) (*MemberResponse, error) {
+	ret := _m.Called(listID, segmentID, email)
+
+	var r0 *MemberResponse
+	if rf, ok := ret.Get(0).(func(string, int, string) *MemberResponse); ok {
+		r0 = rf(listID, segmentID, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MemberResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int, string) error); ok {
+		r1 = rf(listID, segmentID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveSegmentMember ...
+func (_m *ClientMock) RemoveSegmentMember(listID string, segmentID int, email string) error {
+	ret := _m.Called(listID, segmentID, email)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, string) error); ok {
+		r0 = rf(listID, segmentID, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BatchSegmentMembers ...
+func (_m *ClientMock) BatchSegmentMembers(listID string, segmentID int, membersToAdd []string, membersToRemove []string) (*SegmentBatchResponse, error) {
+	ret := _m.Called(listID, segmentID, membersToAdd, membersToRemove)
+
+	var r0 *SegmentBatchResponse
+	if rf, ok := ret.Get(0).(func(string, int, []string, []string) *SegmentBatchResponse); ok {
+		r0 = rf(listID, segmentID, membersToAdd, membersToRemove)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SegmentBatchResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int, []string, []string) error); ok {
+		r1 = rf(listID, segmentID, membersToAdd, membersToRemove)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchTags ...
+func (_m *ClientMock) SearchTags(listID string, name string) (*TagSearchResponse, error) {
+	ret := _m.Called(listID, name)
+
+	var r0 *TagSearchResponse
+	if rf, ok := ret.Get(0).(func(string, string) *TagSearchResponse); ok {
+		r0 = rf(listID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*TagSearchResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(listID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListWebhooks ...
+func (_m *ClientMock) ListWebhooks(listID string) (*ListWebhooksResponse, error) {
+	ret := _m.Called(listID)
+
+	var r0 *ListWebhooksResponse
+	if rf, ok := ret.Get(0).(func(string) *ListWebhooksResponse); ok {
+		r0 = rf(listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListWebhooksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWebhook ...
+func (_m *ClientMock) GetWebhook(listID string, webhookID string) (*Webhook, error) {
+	ret := _m.Called(listID, webhookID)
+
+	var r0 *Webhook
+	if rf, ok := ret.Get(0).(func(string, string) *Webhook); ok {
+		r0 = rf(listID, webhookID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Webhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(listID, webhookID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateWebhook ...
+func (_m *ClientMock) CreateWebhook(listID string, params *WebhookParams) (*Webhook, error) {
+	ret := _m.Called(listID, params)
+
+	var r0 *Webhook
+	if rf, ok := ret.Get(0).(func(string, *WebhookParams) *Webhook); ok {
+		r0 = rf(listID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Webhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *WebhookParams) error); ok {
+		r1 = rf(listID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWebhook ...
+func (_m *ClientMock) UpdateWebhook(listID string, webhookID string, params *WebhookParams) (*Webhook, error) {
+	ret := _m.Called(listID, webhookID, params)
+
+	var r0 *Webhook
+	if rf, ok := ret.Get(0).(func(string, string, *WebhookParams) *Webhook); ok {
+		r0 = rf(listID, webhookID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Webhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, *WebhookParams) error); ok {
+		r1 = rf(listID, webhookID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteWebhook ...
+func (_m *ClientMock) DeleteWebhook(listID string, webhookID string) error {
+	ret := _m.Called(listID, webhookID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(listID, webhookID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListSignupForms ...
+func (_m *ClientMock) ListSignupForms(listID string) (*ListSignupFormsResponse, error) {
+	ret := _m.Called(listID)
+
+	var r0 *ListSignupFormsResponse
+	if rf, ok := ret.Get(0).(func(string) *ListSignupFormsResponse); ok {
+		r0 = rf(listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListSignupFormsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomizeSignupForm ...
+func (_m *ClientMock) CustomizeSignupForm(listID string, params *SignupFormParams) (*SignupForm, error) {
+	ret := _m.Called(listID, params)
+
+	var r0 *SignupForm
+	if rf, ok := ret.Get(0).(func(string, *SignupFormParams) *SignupForm); ok {
+		r0 = rf(listID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SignupForm)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *SignupFormParams) error); ok {
+		r1 = rf(listID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetGrowthHistory ...
+func (_m *ClientMock) GetGrowthHistory(listID string, params *GrowthHistoryParams) (*GrowthHistoryResponse, error) {
+	ret := _m.Called(listID, params)
+
+	var r0 *GrowthHistoryResponse
+	if rf, ok := ret.Get(0).(func(string, *GrowthHistoryParams) *GrowthHistoryResponse); ok {
+		r0 = rf(listID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GrowthHistoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *GrowthHistoryParams) error); ok {
+		r1 = rf(listID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetGrowthHistoryMonth ...
+func (_m *ClientMock) GetGrowthHistoryMonth(listID string, month string) (*GrowthHistory, error) {
+	ret := _m.Called(listID, month)
+
+	var r0 *GrowthHistory
+	if rf, ok := ret.Get(0).(func(string, string) *GrowthHistory); ok {
+		r0 = rf(listID, month)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GrowthHistory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(listID, month)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetListActivity ...
+func (_m *ClientMock) GetListActivity(listID string) (*ListActivityResponse, error) {
+	ret := _m.Called(listID)
+
+	var r0 *ListActivityResponse
+	if rf, ok := ret.Get(0).(func(string) *ListActivityResponse); ok {
+		r0 = rf(listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListActivityResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAbuseReports ...
+func (_m *ClientMock) ListAbuseReports(listID string, params *PaginationParams) (*ListAbuseReportsResponse, error) {
+	ret := _m.Called(listID, params)
+
+	var r0 *ListAbuseReportsResponse
+	if rf, ok := ret.Get(0).(func(string, *PaginationParams) *ListAbuseReportsResponse); ok {
+		r0 = rf(listID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListAbuseReportsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *PaginationParams) error); ok {
+		r1 = rf(listID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAbuseReport ...
+func (_m *ClientMock) GetAbuseReport(listID string, reportID int) (*AbuseReport, error) {
+	ret := _m.Called(listID, reportID)
+
+	var r0 *AbuseReport
+	if rf, ok := ret.Get(0).(func(string, int) *AbuseReport); ok {
+		r0 = rf(listID, reportID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*AbuseReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(listID, reportID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetListClients ...
+func (_m *ClientMock) GetListClients(listID string) (*ListClientsResponse, error) {
+	ret := _m.Called(listID)
+
+	var r0 *ListClientsResponse
+	if rf, ok := ret.Get(0).(func(string) *ListClientsResponse); ok {
+		r0 = rf(listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListClientsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetListLocations ...
+func (_m *ClientMock) GetListLocations(listID string) (*ListLocationsResponse, error) {
+	ret := _m.Called(listID)
+
+	var r0 *ListLocationsResponse
+	if rf, ok := ret.Get(0).(func(string) *ListLocationsResponse); ok {
+		r0 = rf(listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListLocationsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BulkSubscribeWithOptions ...
+func (_m *ClientMock) BulkSubscribeWithOptions(listID string, members []BatchMember, options *BulkSubscribeOptions) (*BatchSubscribeResponse, error) {
+	ret := _m.Called(listID, members, options)
+
+	var r0 *BatchSubscribeResponse
+	if rf, ok := ret.Get(0).(func(string, []BatchMember, *BulkSubscribeOptions) *BatchSubscribeResponse); ok {
+		r0 = rf(listID, members, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*BatchSubscribeResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, []BatchMember, *BulkSubscribeOptions) error); ok {
+		r1 = rf(listID, members, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSurveys ...
+func (_m *ClientMock) ListSurveys(listID string) (*ListSurveysResponse, error) {
+	ret := _m.Called(listID)
+
+	var r0 *ListSurveysResponse
+	if rf, ok := ret.Get(0).(func(string) *ListSurveysResponse); ok {
+		r0 = rf(listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListSurveysResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSurvey ...
+func (_m *ClientMock) GetSurvey(listID string, surveyID string) (*Survey, error) {
+	ret := _m.Called(listID, surveyID)
+
+	var r0 *Survey
+	if rf, ok := ret.Get(0).(func(string, string) *Survey); ok {
+		r0 = rf(listID, surveyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Survey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(listID, surveyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PublishSurvey ...
+func (_m *ClientMock) PublishSurvey(listID string, surveyID string) (*Survey, error) {
+	ret := _m.Called(listID, surveyID)
+
+	var r0 *Survey
+	if rf, ok := ret.Get(0).(func(string, string) *Survey); ok {
+		r0 = rf(listID, surveyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Survey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(listID, surveyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnpublishSurvey ...
+func (_m *ClientMock) UnpublishSurvey(listID string, surveyID string) (*Survey, error) {
+	ret := _m.Called(listID, surveyID)
+
+	var r0 *Survey
+	if rf, ok := ret.Get(0).(func(string, string) *Survey); ok {
+		r0 = rf(listID, surveyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Survey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(listID, surveyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSurveyResponses ...
+func (_m *ClientMock) ListSurveyResponses(surveyID string, params *SurveyResponsesParams) (*ListSurveyResponsesResponse, error) {
+	ret := _m.Called(surveyID, params)
+
+	var r0 *ListSurveyResponsesResponse
+	if rf, ok := ret.Get(0).(func(string, *SurveyResponsesParams) *ListSurveyResponsesResponse); ok {
+		r0 = rf(surveyID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListSurveyResponsesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *SurveyResponsesParams) error); ok {
+		r1 = rf(surveyID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSurveyResponse ...
+func (_m *ClientMock) GetSurveyResponse(surveyID string, responseID string) (*SurveyResponse, error) {
+	ret := _m.Called(surveyID, responseID)
+
+	var r0 *SurveyResponse
+	if rf, ok := ret.Get(0).(func(string, string) *SurveyResponse); ok {
+		r0 = rf(surveyID, responseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SurveyResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(surveyID, responseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetListStats ...
+func (_m *ClientMock) GetListStats(listID string) (*ListStats, error) {
+	ret := _m.Called(listID)
+
+	var r0 *ListStats
+	if rf, ok := ret.Get(0).(func(string) *ListStats); ok {
+		r0 = rf(listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CloneList ...
+func (_m *ClientMock) CloneList(listID string, name string) (*ListResponse, error) {
+	ret := _m.Called(listID, name)
+
+	var r0 *ListResponse
+	if rf, ok := ret.Get(0).(func(string, string) *ListResponse); ok {
+		r0 = rf(listID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(listID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCampaign ...
+func (_m *ClientMock) CreateCampaign(params *CreateCampaignParams) (*Campaign, error) {
+	ret := _m.Called(params)
+
+	var r0 *Campaign
+	if rf, ok := ret.Get(0).(func(*CreateCampaignParams) *Campaign); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Campaign)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*CreateCampaignParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCampaigns ...
+func (_m *ClientMock) ListCampaigns(params *ListCampaignsParams) (*ListCampaignsResponse, error) {
+	ret := _m.Called(params)
+
+	var r0 *ListCampaignsResponse
+	if rf, ok := ret.Get(0).(func(*ListCampaignsParams) *ListCampaignsResponse); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListCampaignsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ListCampaignsParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCampaign ...
+func (_m *ClientMock) GetCampaign(campaignID string) (*Campaign, error) {
+	ret := _m.Called(campaignID)
+
+	var r0 *Campaign
+	if rf, ok := ret.Get(0).(func(string) *Campaign); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Campaign)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCampaign ...
+func (_m *ClientMock) UpdateCampaign(campaignID string, params *UpdateCampaignParams) (*Campaign, error) {
+	ret := _m.Called(campaignID, params)
+
+	var r0 *Campaign
+	if rf, ok := ret.Get(0).(func(string, *UpdateCampaignParams) *Campaign); ok {
+		r0 = rf(campaignID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Campaign)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *UpdateCampaignParams) error); ok {
+		r1 = rf(campaignID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCampaign ...
+func (_m *ClientMock) DeleteCampaign(campaignID string) error {
+	ret := _m.Called(campaignID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(campaignID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetCampaignContent ...
+func (_m *ClientMock) SetCampaignContent(campaignID string, params *CampaignContentParams) (*CampaignContent, error) {
+	ret := _m.Called(campaignID, params)
+
+	var r0 *CampaignContent
+	if rf, ok := ret.Get(0).(func(string, *CampaignContentParams) *CampaignContent); ok {
+		r0 = rf(campaignID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CampaignContent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *CampaignContentParams) error); ok {
+		r1 = rf(campaignID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCampaignContent ...
+func (_m *ClientMock) GetCampaignContent(campaignID string) (*CampaignContent, error) {
+	ret := _m.Called(campaignID)
+
+	var r0 *CampaignContent
+	if rf, ok := ret.Get(0).(func(string) *CampaignContent); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CampaignContent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SendCampaign ...
+func (_m *ClientMock) SendCampaign(campaignID string) error {
+	ret := _m.Called(campaignID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(campaignID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScheduleCampaign ...
+func (_m *ClientMock) ScheduleCampaign(campaignID string, scheduleTime time.Time, options *ScheduleOptions) error {
+	ret := _m.Called(campaignID, scheduleTime, options)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, time.Time, *ScheduleOptions) error); ok {
+		r0 = rf(campaignID, scheduleTime, options)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnscheduleCampaign ...
+func (_m *ClientMock) UnscheduleCampaign(campaignID string) error {
+	ret := _m.Called(campaignID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(campaignID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendTestEmail ...
+func (_m *ClientMock) SendTestEmail(campaignID string, emails []string, sendType string) error {
+	ret := _m.Called(campaignID, emails, sendType)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string, string) error); ok {
+		r0 = rf(campaignID, emails, sendType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetSendChecklist ...
+func (_m *ClientMock) GetSendChecklist(campaignID string) (*SendChecklist, error) {
+	ret := _m.Called(campaignID)
+
+	var r0 *SendChecklist
+	if rf, ok := ret.Get(0).(func(string) *SendChecklist); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SendChecklist)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PauseCampaign ...
+func (_m *ClientMock) PauseCampaign(campaignID string) error {
+	ret := _m.Called(campaignID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(campaignID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeCampaign ...
+func (_m *ClientMock) ResumeCampaign(campaignID string) error {
+	ret := _m.Called(campaignID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(campaignID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplicateCampaign ...
+func (_m *ClientMock) ReplicateCampaign(campaignID string) (*Campaign, error) {
+	ret := _m.Called(campaignID)
+
+	var r0 *Campaign
+	if rf, ok := ret.Get(0).(func(string) *Campaign); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Campaign)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelSend ...
+func (_m *ClientMock) CancelSend(campaignID string) error {
+	ret := _m.Called(campaignID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(campaignID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListCampaignFeedback ...
+func (_m *ClientMock) ListCampaignFeedback(campaignID string) (*ListCampaignFeedbackResponse, error) {
+	ret := _m.Called(campaignID)
+
+	var r0 *ListCampaignFeedbackResponse
+	if rf, ok := ret.Get(0).(func(string) *ListCampaignFeedbackResponse); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListCampaignFeedbackResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCampaignFeedback ...
+func (_m *ClientMock) GetCampaignFeedback(campaignID string, feedbackID int) (*CampaignFeedback, error) {
+	ret := _m.Called(campaignID, feedbackID)
+
+	var r0 *CampaignFeedback
+	if rf, ok := ret.Get(0).(func(string, int) *CampaignFeedback); ok {
+		r0 = rf(campaignID, feedbackID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CampaignFeedback)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(campaignID, feedbackID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCampaignFeedback ...
+func (_m *ClientMock) CreateCampaignFeedback(campaignID string, params *CampaignFeedbackParams) (*CampaignFeedback, error) {
+	ret := _m.Called(campaignID, params)
+
+	var r0 *CampaignFeedback
+	if rf, ok := ret.Get(0).(func(string, *CampaignFeedbackParams) *CampaignFeedback); ok {
+		r0 = rf(campaignID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CampaignFeedback)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *CampaignFeedbackParams) error); ok {
+		r1 = rf(campaignID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCampaignFeedback ...
+func (_m *ClientMock) UpdateCampaignFeedback(campaignID string, feedbackID int, params *CampaignFeedbackParams) (*CampaignFeedback, error) {
+	ret := _m.Called(campaignID, feedbackID, params)
+
+	var r0 *CampaignFeedback
+	if rf, ok := ret.Get(0).(func(string, int, *CampaignFeedbackParams) *CampaignFeedback); ok {
+		r0 = rf(campaignID, feedbackID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CampaignFeedback)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int, *CampaignFeedbackParams) error); ok {
+		r1 = rf(campaignID, feedbackID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCampaignFeedback ...
+func (_m *ClientMock) DeleteCampaignFeedback(campaignID string, feedbackID int) error {
+	ret := _m.Called(campaignID, feedbackID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int) error); ok {
+		r0 = rf(campaignID, feedbackID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListCampaignFolders ...
+func (_m *ClientMock) ListCampaignFolders(params *PaginationParams) (*ListCampaignFoldersResponse, error) {
+	ret := _m.Called(params)
+
+	var r0 *ListCampaignFoldersResponse
+	if rf, ok := ret.Get(0).(func(*PaginationParams) *ListCampaignFoldersResponse); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListCampaignFoldersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*PaginationParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCampaignFolder ...
+func (_m *ClientMock) GetCampaignFolder(folderID string) (*Folder, error) {
+	ret := _m.Called(folderID)
+
+	var r0 *Folder
+	if rf, ok := ret.Get(0).(func(string) *Folder); ok {
+		r0 = rf(folderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Folder)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(folderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCampaignFolder ...
+func (_m *ClientMock) CreateCampaignFolder(name string) (*Folder, error) {
+	ret := _m.Called(name)
+
+	var r0 *Folder
+	if rf, ok := ret.Get(0).(func(string) *Folder); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Folder)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCampaignFolder ...
+func (_m *ClientMock) UpdateCampaignFolder(folderID string, name string) (*Folder, error) {
+	ret := _m.Called(folderID, name)
+
+	var r0 *Folder
+	if rf, ok := ret.Get(0).(func(string, string) *Folder); ok {
+		r0 = rf(folderID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Folder)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(folderID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCampaignFolder ...
+func (_m *ClientMock) DeleteCampaignFolder(folderID string) error {
+	ret := _m.Called(folderID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(folderID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateResend ...
+func (_m *ClientMock) CreateResend(campaignID string, shortcutType string) (*Campaign, error) {
+	ret := _m.Called(campaignID, shortcutType)
+
+	var r0 *Campaign
+	if rf, ok := ret.Get(0).(func(string, string) *Campaign); ok {
+		r0 = rf(campaignID, shortcutType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Campaign)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(campaignID, shortcutType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchCampaigns ...
+func (_m *ClientMock) SearchCampaigns(query string) (*SearchCampaignsResponse, error) {
+	ret := _m.Called(query)
+
+	var r0 *SearchCampaignsResponse
+	if rf, ok := ret.Get(0).(func(string) *SearchCampaignsResponse); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SearchCampaignsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTemplates ...
+func (_m *ClientMock) ListTemplates(params *ListTemplatesParams) (*ListTemplatesResponse, error) {
+	ret := _m.Called(params)
+
+	var r0 *ListTemplatesResponse
+	if rf, ok := ret.Get(0).(func(*ListTemplatesParams) *ListTemplatesResponse); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListTemplatesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ListTemplatesParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTemplate ...
+func (_m *ClientMock) GetTemplate(templateID int) (*Template, error) {
+	ret := _m.Called(templateID)
+
+	var r0 *Template
+	if rf, ok := ret.Get(0).(func(int) *Template); ok {
+		r0 = rf(templateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Template)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(templateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTemplate ...
+func (_m *ClientMock) CreateTemplate(params *TemplateParams) (*Template, error) {
+	ret := _m.Called(params)
+
+	var r0 *Template
+	if rf, ok := ret.Get(0).(func(*TemplateParams) *Template); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Template)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*TemplateParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateTemplate ...
+func (_m *ClientMock) UpdateTemplate(templateID int, params *TemplateParams) (*Template, error) {
+	ret := _m.Called(templateID, params)
+
+	var r0 *Template
+	if rf, ok := ret.Get(0).(func(int, *TemplateParams) *Template); ok {
+		r0 = rf(templateID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Template)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int, *TemplateParams) error); ok {
+		r1 = rf(templateID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTemplate ...
+func (_m *ClientMock) DeleteTemplate(templateID int) error {
+	ret := _m.Called(templateID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(templateID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetTemplateDefaultContent ...
+func (_m *ClientMock) GetTemplateDefaultContent(templateID int) (*TemplateDefaultContent, error) {
+	ret := _m.Called(templateID)
+
+	var r0 *TemplateDefaultContent
+	if rf, ok := ret.Get(0).(func(int) *TemplateDefaultContent); ok {
+		r0 = rf(templateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*TemplateDefaultContent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(templateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTemplateFolders ...
+func (_m *ClientMock) ListTemplateFolders(params *PaginationParams) (*ListTemplateFoldersResponse, error) {
+	ret := _m.Called(params)
+
+	var r0 *ListTemplateFoldersResponse
+	if rf, ok := ret.Get(0).(func(*PaginationParams) *ListTemplateFoldersResponse); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListTemplateFoldersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*PaginationParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTemplateFolder ...
+func (_m *ClientMock) GetTemplateFolder(folderID string) (*Folder, error) {
+	ret := _m.Called(folderID)
+
+	var r0 *Folder
+	if rf, ok := ret.Get(0).(func(string) *Folder); ok {
+		r0 = rf(folderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Folder)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(folderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTemplateFolder ...
+func (_m *ClientMock) CreateTemplateFolder(name string) (*Folder, error) {
+	ret := _m.Called(name)
+
+	var r0 *Folder
+	if rf, ok := ret.Get(0).(func(string) *Folder); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Folder)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateTemplateFolder ...
+func (_m *ClientMock) UpdateTemplateFolder(folderID string, name string) (*Folder, error) {
+	ret := _m.Called(folderID, name)
+
+	var r0 *Folder
+	if rf, ok := ret.Get(0).(func(string, string) *Folder); ok {
+		r0 = rf(folderID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Folder)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(folderID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTemplateFolder ...
+func (_m *ClientMock) DeleteTemplateFolder(folderID string) error {
+	ret := _m.Called(folderID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(folderID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateTemplateFromReader ...
+func (_m *ClientMock) CreateTemplateFromReader(name string, r io.Reader, folderID string) (*Template, error) {
+	ret := _m.Called(name, r, folderID)
+
+	var r0 *Template
+	if rf, ok := ret.Get(0).(func(string, io.Reader, string) *Template); ok {
+		r0 = rf(name, r, folderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Template)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, io.Reader, string) error); ok {
+		r1 = rf(name, r, folderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTemplateFromFile ...
+func (_m *ClientMock) CreateTemplateFromFile(name string, path string, folderID string) (*Template, error) {
+	ret := _m.Called(name, path, folderID)
+
+	var r0 *Template
+	if rf, ok := ret.Get(0).(func(string, string, string) *Template); ok {
+		r0 = rf(name, path, folderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Template)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(name, path, folderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReport ...
+func (_m *ClientMock) GetReport(campaignID string) (*Report, error) {
+	ret := _m.Called(campaignID)
+
+	var r0 *Report
+	if rf, ok := ret.Get(0).(func(string) *Report); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Report)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetClickDetails ...
+func (_m *ClientMock) GetClickDetails(campaignID string, params *PaginationParams) (*ClickDetailsResponse, error) {
+	ret := _m.Called(campaignID, params)
+
+	var r0 *ClickDetailsResponse
+	if rf, ok := ret.Get(0).(func(string, *PaginationParams) *ClickDetailsResponse); ok {
+		r0 = rf(campaignID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ClickDetailsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *PaginationParams) error); ok {
+		r1 = rf(campaignID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetClickDetailsMembers ...
+func (_m *ClientMock) GetClickDetailsMembers(campaignID string, linkID string, params *PaginationParams) (*ClickDetailsMembersResponse, error) {
+	ret := _m.Called(campaignID, linkID, params)
+
+	var r0 *ClickDetailsMembersResponse
+	if rf, ok := ret.Get(0).(func(string, string, *PaginationParams) *ClickDetailsMembersResponse); ok {
+		r0 = rf(campaignID, linkID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ClickDetailsMembersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, *PaginationParams) error); ok {
+		r1 = rf(campaignID, linkID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOpenDetails ...
+func (_m *ClientMock) GetOpenDetails(campaignID string, params *OpenDetailsParams) (*OpenDetailsResponse, error) {
+	ret := _m.Called(campaignID, params)
+
+	var r0 *OpenDetailsResponse
+	if rf, ok := ret.Get(0).(func(string, *OpenDetailsParams) *OpenDetailsResponse); ok {
+		r0 = rf(campaignID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*OpenDetailsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *OpenDetailsParams) error); ok {
+		r1 = rf(campaignID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEmailActivity ...
+func (_m *ClientMock) GetEmailActivity(campaignID string, params *EmailActivityParams) (*EmailActivityResponse, error) {
+	ret := _m.Called(campaignID, params)
+
+	var r0 *EmailActivityResponse
+	if rf, ok := ret.Get(0).(func(string, *EmailActivityParams) *EmailActivityResponse); ok {
+		r0 = rf(campaignID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*EmailActivityResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *EmailActivityParams) error); ok {
+		r1 = rf(campaignID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMemberEmailActivity ...
+func (_m *ClientMock) GetMemberEmailActivity(campaignID string, email string, since time.Time) (*EmailActivity, error) {
+	ret := _m.Called(campaignID, email, since)
+
+	var r0 *EmailActivity
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) *EmailActivity); ok {
+		r0 = rf(campaignID, email, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*EmailActivity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, time.Time) error); ok {
+		r1 = rf(campaignID, email, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUnsubscribed ...
+func (_m *ClientMock) GetUnsubscribed(campaignID string, params *PaginationParams) (*UnsubscribedResponse, error) {
+	ret := _m.Called(campaignID, params)
+
+	var r0 *UnsubscribedResponse
+	if rf, ok := ret.Get(0).(func(string, *PaginationParams) *UnsubscribedResponse); ok {
+		r0 = rf(campaignID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*UnsubscribedResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *PaginationParams) error); ok {
+		r1 = rf(campaignID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSentTo ...
+func (_m *ClientMock) GetSentTo(campaignID string, params *PaginationParams) (*SentToResponse, error) {
+	ret := _m.Called(campaignID, params)
+
+	var r0 *SentToResponse
+	if rf, ok := ret.Get(0).(func(string, *PaginationParams) *SentToResponse); ok {
+		r0 = rf(campaignID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SentToResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *PaginationParams) error); ok {
+		r1 = rf(campaignID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDomainPerformance ...
+func (_m *ClientMock) GetDomainPerformance(campaignID string) (*DomainPerformanceResponse, error) {
+	ret := _m.Called(campaignID)
+
+	var r0 *DomainPerformanceResponse
+	if rf, ok := ret.Get(0).(func(string) *DomainPerformanceResponse); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*DomainPerformanceResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEepurlReport ...
+func (_m *ClientMock) GetEepurlReport(campaignID string) (*EepurlReport, error) {
+	ret := _m.Called(campaignID)
+
+	var r0 *EepurlReport
+	if rf, ok := ret.Get(0).(func(string) *EepurlReport); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*EepurlReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReportLocations ...
+func (_m *ClientMock) GetReportLocations(campaignID string, params *PaginationParams) (*ReportLocationsResponse, error) {
+	ret := _m.Called(campaignID, params)
+
+	var r0 *ReportLocationsResponse
+	if rf, ok := ret.Get(0).(func(string, *PaginationParams) *ReportLocationsResponse); ok {
+		r0 = rf(campaignID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ReportLocationsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *PaginationParams) error); ok {
+		r1 = rf(campaignID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSubReports ...
+func (_m *ClientMock) GetSubReports(campaignID string) (*SubReportsResponse, error) {
+	ret := _m.Called(campaignID)
+
+	var r0 *SubReportsResponse
+	if rf, ok := ret.Get(0).(func(string) *SubReportsResponse); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SubReportsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAdvice ...
+func (_m *ClientMock) GetAdvice(campaignID string) (*AdviceResponse, error) {
+	ret := _m.Called(campaignID)
+
+	var r0 *AdviceResponse
+	if rf, ok := ret.Get(0).(func(string) *AdviceResponse); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*AdviceResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCampaignAbuseReports ...
+func (_m *ClientMock) GetCampaignAbuseReports(campaignID string) (*CampaignAbuseReportsResponse, error) {
+	ret := _m.Called(campaignID)
+
+	var r0 *CampaignAbuseReportsResponse
+	if rf, ok := ret.Get(0).(func(string) *CampaignAbuseReportsResponse); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CampaignAbuseReportsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCampaignAbuseReport ...
+func (_m *ClientMock) GetCampaignAbuseReport(campaignID string, reportID int) (*AbuseReport, error) {
+	ret := _m.Called(campaignID, reportID)
+
+	var r0 *AbuseReport
+	if rf, ok := ret.Get(0).(func(string, int) *AbuseReport); ok {
+		r0 = rf(campaignID, reportID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*AbuseReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(campaignID, reportID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportEmailActivityCSV ...
+func (_m *ClientMock) ExportEmailActivityCSV(campaignID string, w io.Writer) error {
+	ret := _m.Called(campaignID, w)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Writer) error); ok {
+		r0 = rf(campaignID, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportSentToCSV ...
+func (_m *ClientMock) ExportSentToCSV(campaignID string, w io.Writer) error {
+	ret := _m.Called(campaignID, w)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Writer) error); ok {
+		r0 = rf(campaignID, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportOpenDetailsCSV ...
+func (_m *ClientMock) ExportOpenDetailsCSV(campaignID string, w io.Writer) error {
+	ret := _m.Called(campaignID, w)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Writer) error); ok {
+		r0 = rf(campaignID, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListFacebookAds ...
+func (_m *ClientMock) ListFacebookAds(params *PaginationParams) (*ListFacebookAdsResponse, error) {
+	ret := _m.Called(params)
+
+	var r0 *ListFacebookAdsResponse
+	if rf, ok := ret.Get(0).(func(*PaginationParams) *ListFacebookAdsResponse); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListFacebookAdsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*PaginationParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFacebookAd ...
+func (_m *ClientMock) GetFacebookAd(outreachID string) (*FacebookAd, error) {
+	ret := _m.Called(outreachID)
+
+	var r0 *FacebookAd
+	if rf, ok := ret.Get(0).(func(string) *FacebookAd); ok {
+		r0 = rf(outreachID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*FacebookAd)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(outreachID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFacebookAdReport ...
+func (_m *ClientMock) GetFacebookAdReport(outreachID string) (*FacebookAdReport, error) {
+	ret := _m.Called(outreachID)
+
+	var r0 *FacebookAdReport
+	if rf, ok := ret.Get(0).(func(string) *FacebookAdReport); ok {
+		r0 = rf(outreachID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*FacebookAdReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(outreachID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateLandingPage ...
+func (_m *ClientMock) CreateLandingPage(params *LandingPageParams) (*LandingPage, error) {
+	ret := _m.Called(params)
+
+	var r0 *LandingPage
+	if rf, ok := ret.Get(0).(func(*LandingPageParams) *LandingPage); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*LandingPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*LandingPageParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLandingPage ...
+func (_m *ClientMock) GetLandingPage(pageID string) (*LandingPage, error) {
+	ret := _m.Called(pageID)
+
+	var r0 *LandingPage
+	if rf, ok := ret.Get(0).(func(string) *LandingPage); ok {
+		r0 = rf(pageID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*LandingPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(pageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateLandingPage ...
+func (_m *ClientMock) UpdateLandingPage(pageID string, params *LandingPageParams) (*LandingPage, error) {
+	ret := _m.Called(pageID, params)
+
+	var r0 *LandingPage
+	if rf, ok := ret.Get(0).(func(string, *LandingPageParams) *LandingPage); ok {
+		r0 = rf(pageID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*LandingPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *LandingPageParams) error); ok {
+		r1 = rf(pageID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteLandingPage ...
+func (_m *ClientMock) DeleteLandingPage(pageID string) error {
+	ret := _m.Called(pageID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(pageID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PublishLandingPage ...
+func (_m *ClientMock) PublishLandingPage(pageID string) error {
+	ret := _m.Called(pageID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(pageID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnpublishLandingPage ...
+func (_m *ClientMock) UnpublishLandingPage(pageID string) error {
+	ret := _m.Called(pageID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(pageID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetLandingPageContent ...
+func (_m *ClientMock) GetLandingPageContent(pageID string) (*LandingPageContent, error) {
+	ret := _m.Called(pageID)
+
+	var r0 *LandingPageContent
+	if rf, ok := ret.Get(0).(func(string) *LandingPageContent); ok {
+		r0 = rf(pageID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*LandingPageContent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(pageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListLandingPageReports ...
+func (_m *ClientMock) ListLandingPageReports(params *PaginationParams) (*ListLandingPageReportsResponse, error) {
+	ret := _m.Called(params)
+
+	var r0 *ListLandingPageReportsResponse
+	if rf, ok := ret.Get(0).(func(*PaginationParams) *ListLandingPageReportsResponse); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListLandingPageReportsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*PaginationParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLandingPageReport ...
+func (_m *ClientMock) GetLandingPageReport(pageID string) (*LandingPageReport, error) {
+	ret := _m.Called(pageID)
+
+	var r0 *LandingPageReport
+	if rf, ok := ret.Get(0).(func(string) *LandingPageReport); ok {
+		r0 = rf(pageID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*LandingPageReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(pageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAutomations ...
+func (_m *ClientMock) ListAutomations(params *ListAutomationsParams) (*ListAutomationsResponse, error) {
+	ret := _m.Called(params)
+
+	var r0 *ListAutomationsResponse
+	if rf, ok := ret.Get(0).(func(*ListAutomationsParams) *ListAutomationsResponse); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListAutomationsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ListAutomationsParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAutomation ...
+func (_m *ClientMock) GetAutomation(workflowID string) (*Automation, error) {
+	ret := _m.Called(workflowID)
+
+	var r0 *Automation
+	if rf, ok := ret.Get(0).(func(string) *Automation); ok {
+		r0 = rf(workflowID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Automation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(workflowID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetBaseURL ...
 func (_m *ClientMock) SetBaseURL(baseURL *url.URL) {
 	_m.Called(baseURL)
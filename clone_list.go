@@ -0,0 +1,139 @@
+package mailchimp
+
+// CloneList creates a new list named name with the settings, merge fields,
+// interest categories, interests and tags of an existing list. Members are
+// not copied. Merge fields whose tag already exists on the new list, such as
+// the defaults Mailchimp creates, are left as they are. If a step fails, the
+// new list is returned together with the error so it can be finished or
+// deleted by the caller.
+func (c *Client) CloneList(listID string, name string) (*ListResponse, error) {
+	source, err := c.GetList(listID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := c.CreateList(&CreateListParams{
+		Name:                 name,
+		Contact:              source.Contact,
+		PermissionReminder:   source.PermissionReminder,
+		UseArchiveBar:        source.UseArchiveBar,
+		CampaignDefaults:     source.CampaignDefaults,
+		NotifyOnSubscribe:    source.NotifyOnSubscribe,
+		NotifyOnUnsubscribe:  source.NotifyOnUnsubscribe,
+		EmailTypeOption:      source.EmailTypeOption,
+		Visibility:           source.Visibility,
+		DoubleOptin:          source.DoubleOptin,
+		MarketingPermissions: source.MarketingPermissions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.cloneMergeFields(listID, list.ID); err != nil {
+		return list, err
+	}
+	if err := c.cloneInterestCategories(listID, list.ID); err != nil {
+		return list, err
+	}
+	if err := c.cloneTags(listID, list.ID); err != nil {
+		return list, err
+	}
+	return list, nil
+}
+
+func (c *Client) cloneMergeFields(fromListID string, toListID string) error {
+	source, err := c.ListMergeFields(fromListID, &PaginationParams{Count: maxCount})
+	if err != nil {
+		return err
+	}
+	existing, err := c.ListMergeFields(toListID, &PaginationParams{Count: maxCount})
+	if err != nil {
+		return err
+	}
+
+	existingTags := make(map[string]bool, len(existing.MergeFields))
+	for _, mergeField := range existing.MergeFields {
+		existingTags[mergeField.Tag] = true
+	}
+
+	for _, mergeField := range source.MergeFields {
+		if existingTags[mergeField.Tag] {
+			continue
+		}
+		required, public, options := mergeField.Required, mergeField.Public, mergeField.Options
+		_, err := c.CreateMergeField(toListID, &MergeFieldParams{
+			Tag:          mergeField.Tag,
+			Name:         mergeField.Name,
+			Type:         mergeField.Type,
+			Required:     &required,
+			DefaultValue: mergeField.DefaultValue,
+			Public:       &public,
+			DisplayOrder: mergeField.DisplayOrder,
+			Options:      &options,
+			HelpText:     mergeField.HelpText,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) cloneInterestCategories(fromListID string, toListID string) error {
+	source, err := c.ListInterestCategories(fromListID, &ListInterestCategoriesParams{
+		PaginationParams: PaginationParams{Count: maxCount},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, sourceCategory := range source.Categories {
+		category, err := c.CreateInterestCategory(toListID, &InterestCategoryParams{
+			Title:        sourceCategory.Title,
+			DisplayOrder: sourceCategory.DisplayOrder,
+			Type:         sourceCategory.Type,
+		})
+		if err != nil {
+			return err
+		}
+
+		interests, err := c.ListInterests(fromListID, sourceCategory.ID, &PaginationParams{Count: maxCount})
+		if err != nil {
+			return err
+		}
+		for _, interest := range interests.Interests {
+			_, err := c.CreateInterest(toListID, category.ID, &InterestParams{
+				Name:         interest.Name,
+				DisplayOrder: interest.DisplayOrder,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// cloneTags recreates the tags of a list, reading the static segments backing
+// them page by page so lists with many tags are copied in full
+func (c *Client) cloneTags(fromListID string, toListID string) error {
+	params := &ListSegmentsParams{
+		PaginationParams: PaginationParams{Count: maxCount},
+		Type:             SegmentStatic,
+	}
+	for {
+		listSegmentsResponse, err := c.ListSegments(fromListID, params)
+		if err != nil {
+			return err
+		}
+		for _, segment := range listSegmentsResponse.Segments {
+			if _, err := c.createStaticSegment(toListID, segment.Name); err != nil {
+				return err
+			}
+		}
+		params.Offset += len(listSegmentsResponse.Segments)
+		if len(listSegmentsResponse.Segments) == 0 || params.Offset >= listSegmentsResponse.TotalItems {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloneList(t *testing.T) {
+	var created, tags []string
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		var params map[string]interface{}
+		if req.Method == "POST" {
+			assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+			created = append(created, req.URL.Path)
+		}
+
+		switch {
+		case req.Method == "GET" && req.URL.Path == "/lists/0f6b836652":
+			rw.WriteHeader(200)
+			fmt.Fprint(rw, listResponse)
+		case req.Method == "POST" && req.URL.Path == "/lists":
+			assert.Equal(t, "Customers EU", params["name"])
+			assert.Equal(t, "You signed up on our website.", params["permission_reminder"])
+			assert.Equal(t, "New York", params["contact"].(map[string]interface{})["city"])
+
+			rw.WriteHeader(200)
+			fmt.Fprint(rw, `{"id": "new_list", "name": "Customers EU"}`)
+		case req.Method == "GET" && req.URL.Path == "/lists/0f6b836652/merge-fields":
+			assert.Equal(t, "count=1000", req.URL.RawQuery)
+
+			rw.WriteHeader(200)
+			fmt.Fprintf(rw, `{"merge_fields": [{"merge_id": 1, "tag": "FNAME", "name": "First Name", "type": "text"}, %s], "total_items": 2}`, mergeFieldResponse)
+		case req.Method == "GET" && req.URL.Path == "/lists/new_list/merge-fields":
+			rw.WriteHeader(200)
+			fmt.Fprint(rw, `{"merge_fields": [{"merge_id": 1, "tag": "FNAME", "name": "First Name", "type": "text"}], "total_items": 1}`)
+		case req.Method == "POST" && req.URL.Path == "/lists/new_list/merge-fields":
+			assert.Equal(t, "PLAN", params["tag"])
+			assert.Equal(t, "dropdown", params["type"])
+			assert.Equal(t, false, params["required"])
+			assert.Equal(t, []interface{}{"free", "pro"}, params["options"].(map[string]interface{})["choices"])
+
+			rw.WriteHeader(200)
+			fmt.Fprint(rw, mergeFieldResponse)
+		case req.Method == "GET" && req.URL.Path == "/lists/0f6b836652/interest-categories":
+			rw.WriteHeader(200)
+			fmt.Fprintf(rw, `{"categories": [%s], "total_items": 1}`, interestCategoryResponse)
+		case req.Method == "POST" && req.URL.Path == "/lists/new_list/interest-categories":
+			assert.Equal(t, "Product line", params["title"])
+
+			rw.WriteHeader(200)
+			fmt.Fprint(rw, `{"id": "new_category", "title": "Product line", "type": "checkboxes"}`)
+		case req.Method == "GET" && req.URL.Path == "/lists/0f6b836652/interest-categories/a1e9f4b7f6/interests":
+			rw.WriteHeader(200)
+			fmt.Fprint(rw, `{"interests": [{"id": "i1", "name": "Shoes", "display_order": 1}], "total_items": 1}`)
+		case req.Method == "POST" && req.URL.Path == "/lists/new_list/interest-categories/new_category/interests":
+			assert.Equal(t, "Shoes", params["name"])
+
+			rw.WriteHeader(200)
+			fmt.Fprint(rw, `{"id": "i2", "name": "Shoes"}`)
+		case req.Method == "GET" && req.URL.Path == "/lists/0f6b836652/segments":
+			// The tags are returned one per page to check they are all read
+			rw.WriteHeader(200)
+			switch req.URL.RawQuery {
+			case "count=1000&type=static":
+				fmt.Fprint(rw, `{"segments": [{"id": 17, "name": "customer", "type": "static"}], "total_items": 2}`)
+			case "count=1000&offset=1&type=static":
+				fmt.Fprint(rw, `{"segments": [{"id": 18, "name": "vip", "type": "static"}], "total_items": 2}`)
+			default:
+				t.Errorf("unexpected query %s", req.URL.RawQuery)
+			}
+		case req.Method == "POST" && req.URL.Path == "/lists/new_list/segments":
+			tags = append(tags, params["name"].(string))
+
+			rw.WriteHeader(200)
+			fmt.Fprint(rw, `{"id": 31, "name": "customer", "type": "static"}`)
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	list, err := client.CloneList("0f6b836652", "Customers EU")
+	assert.NoError(t, err)
+	assert.Equal(t, "new_list", list.ID)
+	assert.Equal(t, []string{
+		"/lists",
+		"/lists/new_list/merge-fields",
+		"/lists/new_list/interest-categories",
+		"/lists/new_list/interest-categories/new_category/interests",
+		"/lists/new_list/segments",
+		"/lists/new_list/segments",
+	}, created)
+	assert.Equal(t, []string{"customer", "vip"}, tags)
+}
+
+func TestCloneListPartialFailure(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == "GET" && req.URL.Path == "/lists/0f6b836652":
+			rw.WriteHeader(200)
+			fmt.Fprint(rw, listResponse)
+		case req.Method == "POST" && req.URL.Path == "/lists":
+			rw.WriteHeader(200)
+			fmt.Fprint(rw, `{"id": "new_list", "name": "Customers EU"}`)
+		default:
+			rw.WriteHeader(404)
+			fmt.Fprint(rw, notFoundErrorResponse)
+		}
+	})
+	defer server.Close()
+
+	list, err := client.CloneList("0f6b836652", "Customers EU")
+	assert.Error(t, err)
+	assert.Equal(t, "new_list", list.ID)
+}
@@ -0,0 +1,36 @@
+package mailchimp
+
+import (
+	"fmt"
+
+	"github.com/RichardKnop/go-mailchimp/status"
+)
+
+// DeleteMember archives a list member. Archived members can be added back to the list later.
+func (c *Client) DeleteMember(listID string, email string) error {
+	return c.request(
+		"DELETE",
+		fmt.Sprintf("/lists/%s/members/%s", listID, SubscriberHash(email)),
+		nil,
+		nil,
+	)
+}
+
+// PermanentDeleteMember permanently deletes a list member and all their data.
+// Permanently deleted members cannot be re-imported with the same email address.
+func (c *Client) PermanentDeleteMember(listID string, email string) error {
+	return c.request(
+		"POST",
+		fmt.Sprintf("/lists/%s/members/%s/actions/delete-permanent", listID, SubscriberHash(email)),
+		nil,
+		nil,
+	)
+}
+
+// UnarchiveMember restores an archived list member with the given status
+func (c *Client) UnarchiveMember(listID string, email string, memberStatus status.MemberStatus) (*MemberResponse, error) {
+	return c.AddOrUpdateMember(listID, email, &AddOrUpdateMemberParams{
+		StatusIfNew: memberStatus,
+		Status:      memberStatus,
+	})
+}
@@ -0,0 +1,66 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/RichardKnop/go-mailchimp/status"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteMember(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.DeleteMember("list_id", "john@reese.com"))
+}
+
+func TestDeleteMemberNotFoundError(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(404)
+		fmt.Fprint(rw, notFoundErrorResponse)
+	})
+	defer server.Close()
+
+	err := client.DeleteMember("list_id", "john@reese.com")
+	assert.Equal(t, "Error 404 Resource Not Found (The requested resource could not be found.)", err.Error())
+}
+
+func TestPermanentDeleteMember(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769/actions/delete-permanent", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.PermanentDeleteMember("list_id", "john@reese.com"))
+}
+
+func TestUnarchiveMember(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, "unsubscribed", params["status"])
+		assert.Equal(t, "unsubscribed", params["status_if_new"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, unsubscribedResponse)
+	})
+	defer server.Close()
+
+	memberResponse, err := client.UnarchiveMember("list_id", "john@reese.com", status.Unsubscribed)
+	assert.NoError(t, err)
+	assert.Equal(t, status.Unsubscribed, memberResponse.Status)
+}
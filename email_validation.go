@@ -0,0 +1,57 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+// EmailValidation - options for validating emails locally before they are sent
+// to Mailchimp, see SetEmailValidation. The syntax is always checked.
+type EmailValidation struct {
+	Lowercase        bool // Lowercase the email.
+	StripPlusAddress bool // Remove the "+tag" part, e.g. john+news@reese.com becomes john@reese.com.
+}
+
+// SetEmailValidation enables local email validation before subscribing members.
+// Pass nil to disable it again.
+func (c *Client) SetEmailValidation(emailValidation *EmailValidation) {
+	c.emailValidation = emailValidation
+}
+
+// NormalizeEmail validates the syntax of email and normalizes it according to
+// emailValidation. The returned error wraps ErrInvalidEmail.
+func NormalizeEmail(email string, emailValidation *EmailValidation) (string, error) {
+	email = strings.TrimSpace(email)
+	address, err := mail.ParseAddress(email)
+	// Reject display names, e.g. "John <john@reese.com>"
+	if err != nil || address.Address != email {
+		return "", fmt.Errorf("%w: %q", ErrInvalidEmail, email)
+	}
+
+	at := strings.LastIndex(email, "@")
+	local, domain := email[:at], email[at+1:]
+	if !strings.Contains(domain, ".") || strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidEmail, email)
+	}
+
+	if emailValidation != nil {
+		if emailValidation.StripPlusAddress {
+			if plus := strings.Index(local, "+"); plus > 0 {
+				local = local[:plus]
+			}
+		}
+		if emailValidation.Lowercase {
+			local, domain = strings.ToLower(local), strings.ToLower(domain)
+		}
+	}
+	return local + "@" + domain, nil
+}
+
+// validateEmail normalizes email if validation is enabled on the client
+func (c *Client) validateEmail(email string) (string, error) {
+	if c.emailValidation == nil {
+		return email, nil
+	}
+	return NormalizeEmail(email, c.emailValidation)
+}
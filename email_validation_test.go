@@ -0,0 +1,56 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeEmail(t *testing.T) {
+	emailValidation := &mailchimp.EmailValidation{Lowercase: true, StripPlusAddress: true}
+
+	email, err := mailchimp.NormalizeEmail(" John+News@Reese.COM ", emailValidation)
+	assert.NoError(t, err)
+	assert.Equal(t, "john@reese.com", email)
+
+	email, err = mailchimp.NormalizeEmail("John+News@Reese.COM", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "John+News@Reese.COM", email)
+
+	for _, invalid := range []string{"", "john", "john@", "@reese.com", "john@localhost", "john@reese.com.", "John <john@reese.com>"} {
+		_, err := mailchimp.NormalizeEmail(invalid, emailValidation)
+		assert.True(t, errors.Is(err, mailchimp.ErrInvalidEmail), invalid)
+	}
+}
+
+func TestSubscribeWithEmailValidation(t *testing.T) {
+	requests := 0
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, "john@reese.com", params["email_address"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, successResponse)
+	})
+	defer server.Close()
+
+	client.SetEmailValidation(&mailchimp.EmailValidation{Lowercase: true, StripPlusAddress: true})
+
+	_, err := client.Subscribe("list_id", "John+News@Reese.com", nil)
+	assert.NoError(t, err)
+
+	memberResponse, err := client.Subscribe("list_id", "john@reese", nil)
+	assert.Nil(t, memberResponse)
+	assert.True(t, errors.Is(err, mailchimp.ErrInvalidEmail))
+
+	// The invalid email never reached the API
+	assert.Equal(t, 1, requests)
+}
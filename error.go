@@ -1,9 +1,45 @@
 package mailchimp
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 )
 
+// ErrMemberExists matches (see errors.Is) error responses for subscribing an
+// address which is already a list member
+var ErrMemberExists = errors.New("mailchimp: member exists")
+
+// ErrMemberInComplianceState matches (see errors.Is) error responses for an
+// address which unsubscribed, bounced or was cleaned and so cannot be
+// re-subscribed directly. Such members can be re-added with pending status.
+var ErrMemberInComplianceState = errors.New("mailchimp: member in compliance state")
+
+// ErrInvalidEmail is returned when local email validation rejects an address
+var ErrInvalidEmail = errors.New("mailchimp: invalid email address")
+
+// ErrDeleteListNotConfirmed is returned by DeleteList when the deletion was not confirmed
+var ErrDeleteListNotConfirmed = errors.New("mailchimp: list deletion must be confirmed")
+
+// ErrTagNotFound is returned when no tag with the given name exists on the list
+var ErrTagNotFound = errors.New("mailchimp: tag not found")
+
+// ErrCampaignNotReady matches (see errors.Is) error responses for sending a
+// campaign which fails its send checklist, e.g. because it has no content
+// or the settings are incomplete
+var ErrCampaignNotReady = errors.New("mailchimp: campaign not ready to send")
+
+// ErrInvalidScheduleTime is returned by ScheduleCampaign when the time is not
+// on a quarter-hour (:00, :15, :30 or :45), which Mailchimp requires
+var ErrInvalidScheduleTime = errors.New("mailchimp: campaigns can only be scheduled on the quarter-hour")
+
+// ErrInvalidScheduleOptions is returned by ScheduleCampaign when Timewarp and
+// BatchDelivery are both set, which Mailchimp does not allow
+var ErrInvalidScheduleOptions = errors.New("mailchimp: timewarp cannot be combined with batch delivery")
+
+// ErrTemplateTooLarge is returned when template HTML exceeds MaxTemplateSize
+var ErrTemplateTooLarge = errors.New("mailchimp: template exceeds the maximum size")
+
 type SubError struct {
 	Field   string `json:"field"`
 	Message string `json:"message"`
@@ -26,3 +62,37 @@ func (e ErrorResponse) Error() string {
 	}
 	return err
 }
+
+// CampaignNotReadyError is returned by SendCampaign when the campaign fails
+// its send checklist. It matches ErrCampaignNotReady (see errors.Is).
+type CampaignNotReadyError struct {
+	ErrorResponse
+	Blocking []SendChecklistItem // The send checklist items keeping the campaign from being sent.
+}
+
+// Error ...
+func (e *CampaignNotReadyError) Error() string {
+	err := e.ErrorResponse.Error()
+	for _, item := range e.Blocking {
+		err += fmt.Sprintf("\nBlocking: %s (%s)", item.Heading, item.Details)
+	}
+	return err
+}
+
+// Unwrap returns the error response, so errors.As still finds an *ErrorResponse
+func (e *CampaignNotReadyError) Unwrap() error {
+	return &e.ErrorResponse
+}
+
+// Is reports whether the error response corresponds to the target sentinel error
+func (e ErrorResponse) Is(target error) bool {
+	switch target {
+	case ErrMemberExists:
+		return e.Title == "Member Exists"
+	case ErrMemberInComplianceState:
+		return e.Title == "Member In Compliance State"
+	case ErrCampaignNotReady:
+		return e.Status == 400 && strings.Contains(strings.ToLower(e.Detail), "not ready to send")
+	}
+	return false
+}
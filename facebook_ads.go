@@ -0,0 +1,153 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// FacebookAdBudget - the budget of a Facebook ad
+type FacebookAdBudget struct {
+	Duration     int     `json:"duration"`      // The duration of the ad in seconds.
+	TotalAmount  float64 `json:"total_amount"`  // The total budget of the ad.
+	CurrencyCode string  `json:"currency_code"` // The ISO 4217 currency code of the budget.
+}
+
+// FacebookAdLocations - the locations a Facebook ad targets
+type FacebookAdLocations struct {
+	Countries []string `json:"countries"` // ISO 3166 2 digit country codes.
+	Regions   []string `json:"regions"`
+	Cities    []string `json:"cities"`
+	Zips      []string `json:"zips"`
+}
+
+// FacebookAdTargetingSpecs - the demographics a Facebook ad targets
+type FacebookAdTargetingSpecs struct {
+	Locations FacebookAdLocations `json:"locations"`
+	Gender    int                 `json:"gender"` // 0 for all, 1 for male and 2 for female.
+	MinAge    int                 `json:"min_age"`
+	MaxAge    int                 `json:"max_age"`
+	Interests []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"interests"`
+}
+
+// FacebookAdAudience - the audience a Facebook ad is shown to
+type FacebookAdAudience struct {
+	Type                  string                   `json:"type"`        // Custom Audience, Lookalike Audience or Interest-based Audience.
+	SourceType            string                   `json:"source_type"` // Facebook or list.
+	IncludeSourceInTarget bool                     `json:"include_source_in_target"`
+	LookalikeCountryCode  string                   `json:"lookalike_country_code"`
+	TargetingSpecs        FacebookAdTargetingSpecs `json:"targeting_specs"`
+}
+
+// FacebookAdReportSummary - the outreach stats of a Facebook ad
+type FacebookAdReportSummary struct {
+	Reach             int     `json:"reach"`       // The number of people who saw the ad.
+	Impressions       int     `json:"impressions"` // The number of times the ad was shown.
+	Clicks            int     `json:"clicks"`      // The number of clicks on the ad.
+	UniqueClicks      int     `json:"unique_clicks"`
+	ClickRate         float64 `json:"click_rate"`
+	CostPerClick      float64 `json:"cost_per_click"`
+	Likes             int     `json:"likes"`
+	Comments          int     `json:"comments"`
+	Shares            int     `json:"shares"`
+	TotalOrders       int     `json:"total_orders"`
+	TotalProductsSold int     `json:"total_products_sold"`
+	TotalRevenue      float64 `json:"total_revenue"`
+	CurrencyCode      string  `json:"currency_code"`
+}
+
+// FacebookAd - see https://developer.mailchimp.com/documentation/mailchimp/reference/facebook-ads/
+type FacebookAd struct {
+	ID             string                  `json:"id"` // The outreach ID of the ad.
+	Name           string                  `json:"name"`
+	Type           string                  `json:"type"`
+	Status         string                  `json:"status"` // E.g. active, paused, completed or canceled.
+	CreateTime     string                  `json:"create_time"`
+	EditTime       string                  `json:"edit_time"`
+	StartTime      string                  `json:"start_time"`
+	EndTime        string                  `json:"end_time"`
+	PausedAt       string                  `json:"paused_at"`
+	CanceledAt     string                  `json:"canceled_at"`
+	PublishedTime  string                  `json:"published_time"`
+	NeedsAttention bool                    `json:"needs_attention"`
+	Recipients     CampaignRecipients      `json:"recipients"`
+	Budget         FacebookAdBudget        `json:"budget"`
+	Audience       FacebookAdAudience      `json:"audience"`
+	ReportSummary  FacebookAdReportSummary `json:"report_summary"`
+}
+
+// ListFacebookAdsResponse ...
+type ListFacebookAdsResponse struct {
+	FacebookAds []FacebookAd `json:"facebook_ads"`
+	TotalItems  int          `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// FacebookAdActivity - the activity of a Facebook ad on a single day
+type FacebookAdActivity struct {
+	Date        string  `json:"date"`
+	Clicks      int     `json:"clicks"`
+	Impressions int     `json:"impressions"`
+	Revenue     float64 `json:"revenue"`
+}
+
+// FacebookAdReport - see https://developer.mailchimp.com/documentation/mailchimp/reference/reports/facebook-ads/
+type FacebookAdReport struct {
+	FacebookAd
+	AudienceActivity struct {
+		Clicks      []FacebookAdActivity `json:"clicks"`
+		Impressions []FacebookAdActivity `json:"impressions"`
+		Revenue     []FacebookAdActivity `json:"revenue"`
+	} `json:"audience_activity"`
+}
+
+// ListFacebookAds returns a page of the Facebook ads in the account
+func (c *Client) ListFacebookAds(params *PaginationParams) (*ListFacebookAdsResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+	}
+	listFacebookAdsResponse := new(ListFacebookAdsResponse)
+	err := c.request(
+		"GET",
+		withQuery("/facebook-ads", query),
+		nil,
+		listFacebookAdsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listFacebookAdsResponse, nil
+}
+
+// GetFacebookAd returns information about a specific Facebook ad
+func (c *Client) GetFacebookAd(outreachID string) (*FacebookAd, error) {
+	facebookAd := new(FacebookAd)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/facebook-ads/%s", outreachID),
+		nil,
+		facebookAd,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return facebookAd, nil
+}
+
+// GetFacebookAdReport returns the report of a specific Facebook ad, including
+// its daily audience activity
+func (c *Client) GetFacebookAdReport(outreachID string) (*FacebookAdReport, error) {
+	facebookAdReport := new(FacebookAdReport)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/reports/facebook-ads/%s", outreachID),
+		nil,
+		facebookAdReport,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return facebookAdReport, nil
+}
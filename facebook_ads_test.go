@@ -0,0 +1,77 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListFacebookAds(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/facebook-ads", req.URL.Path)
+		assert.Equal(t, "count=10&offset=10", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"facebook_ads": [%s], "total_items": 11}`, facebookAdResponse)
+	})
+	defer server.Close()
+
+	listFacebookAdsResponse, err := client.ListFacebookAds(&mailchimp.PaginationParams{Count: 10, Offset: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, 11, listFacebookAdsResponse.TotalItems)
+	assert.Equal(t, "fb_outreach_1", listFacebookAdsResponse.FacebookAds[0].ID)
+	assert.Equal(t, 70.5, listFacebookAdsResponse.FacebookAds[0].Budget.TotalAmount)
+}
+
+func TestGetFacebookAd(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/facebook-ads/fb_outreach_1", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, facebookAdResponse)
+	})
+	defer server.Close()
+
+	facebookAd, err := client.GetFacebookAd("fb_outreach_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "active", facebookAd.Status)
+	assert.Equal(t, "0f6b836652", facebookAd.Recipients.ListID)
+	assert.Equal(t, 604800, facebookAd.Budget.Duration)
+	assert.Equal(t, "Lookalike Audience", facebookAd.Audience.Type)
+	assert.Equal(t, []string{"US"}, facebookAd.Audience.TargetingSpecs.Locations.Countries)
+	assert.Equal(t, 18, facebookAd.Audience.TargetingSpecs.MinAge)
+	assert.Equal(t, 1200, facebookAd.ReportSummary.Reach)
+}
+
+func TestGetFacebookAdReport(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/facebook-ads/fb_outreach_1", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"id": "fb_outreach_1",
+			"report_summary": {"impressions": 3400, "clicks": 85, "cost_per_click": 0.83},
+			"audience_activity": {
+				"clicks": [{"date": "2019-04-02", "clicks": 40}, {"date": "2019-04-03", "clicks": 45}],
+				"impressions": [{"date": "2019-04-02", "impressions": 1500}],
+				"revenue": [{"date": "2019-04-02", "revenue": 120.5}]
+			}
+		}`)
+	})
+	defer server.Close()
+
+	facebookAdReport, err := client.GetFacebookAdReport("fb_outreach_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "fb_outreach_1", facebookAdReport.ID)
+	assert.Equal(t, 0.83, facebookAdReport.ReportSummary.CostPerClick)
+	assert.Len(t, facebookAdReport.AudienceActivity.Clicks, 2)
+	assert.Equal(t, 45, facebookAdReport.AudienceActivity.Clicks[1].Clicks)
+	assert.Equal(t, 1500, facebookAdReport.AudienceActivity.Impressions[0].Impressions)
+	assert.Equal(t, 120.5, facebookAdReport.AudienceActivity.Revenue[0].Revenue)
+}
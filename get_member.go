@@ -0,0 +1,20 @@
+package mailchimp
+
+import (
+	"fmt"
+)
+
+// GetMember returns information about a specific list member
+func (c *Client) GetMember(listID string, email string) (*MemberResponse, error) {
+	memberResponse := new(MemberResponse)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/members/%s", listID, SubscriberHash(email)),
+		nil,
+		memberResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return memberResponse, nil
+}
@@ -0,0 +1,30 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/RichardKnop/go-mailchimp/status"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMember(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, memberWithStatsResponse)
+	})
+	defer server.Close()
+
+	memberResponse, err := client.GetMember("list_id", "John@Reese.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "john@reese.com", memberResponse.EmailAddress)
+	assert.Equal(t, status.Subscribed, memberResponse.Status)
+	assert.Equal(t, 0.5, memberResponse.Stats.AvgOpenRate)
+	assert.Equal(t, 0.25, memberResponse.Stats.AvgClickRate)
+	assert.Equal(t, []mailchimp.MemberTag{{ID: 17, Name: "customer"}}, memberResponse.Tags)
+}
@@ -0,0 +1,74 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// GrowthHistory - a month-by-month summary of a list's growth activity
+type GrowthHistory struct {
+	ListID        string `json:"list_id"`
+	Month         string `json:"month"`         // The month the summary covers, formatted YYYY-MM.
+	Existing      int    `json:"existing"`      // The number of existing members at the start of the month.
+	Imports       int    `json:"imports"`       // The number of members imported during the month.
+	Optins        int    `json:"optins"`        // The number of members who opted in during the month.
+	Subscribed    int    `json:"subscribed"`    // The total number of subscribed members at the end of the month.
+	Unsubscribed  int    `json:"unsubscribed"`  // The number of members who unsubscribed during the month.
+	Reconfirm     int    `json:"reconfirm"`     // The number of members who reconfirmed their subscription during the month.
+	Cleaned       int    `json:"cleaned"`       // The number of members cleaned during the month.
+	Pending       int    `json:"pending"`       // The number of pending members at the end of the month.
+	Deleted       int    `json:"deleted"`       // The number of members deleted during the month.
+	Transactional int    `json:"transactional"` // The number of transactional members at the end of the month.
+}
+
+// GrowthHistoryParams ...
+type GrowthHistoryParams struct {
+	PaginationParams
+	SortDir string // Either ASC or DESC, months are sorted by date.
+}
+
+// GrowthHistoryResponse ...
+type GrowthHistoryResponse struct {
+	History    []GrowthHistory `json:"history"`
+	ListID     string          `json:"list_id"`
+	TotalItems int             `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// GetGrowthHistory returns a month-by-month summary of a list's growth
+func (c *Client) GetGrowthHistory(listID string, params *GrowthHistoryParams) (*GrowthHistoryResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+		if params.SortDir != "" {
+			query.Set("sort_field", "month")
+			query.Set("sort_dir", params.SortDir)
+		}
+	}
+	growthHistoryResponse := new(GrowthHistoryResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/lists/%s/growth-history", listID), query),
+		nil,
+		growthHistoryResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return growthHistoryResponse, nil
+}
+
+// GetGrowthHistoryMonth returns the growth summary of a list for a specific
+// month formatted YYYY-MM
+func (c *Client) GetGrowthHistoryMonth(listID string, month string) (*GrowthHistory, error) {
+	growthHistory := new(GrowthHistory)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/growth-history/%s", listID, month),
+		nil,
+		growthHistory,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return growthHistory, nil
+}
@@ -0,0 +1,48 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetGrowthHistory(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/growth-history", req.URL.Path)
+		assert.Equal(t, "count=12&sort_dir=DESC&sort_field=month", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"history": [%s], "list_id": "list_id", "total_items": 30}`, growthHistoryResponse)
+	})
+	defer server.Close()
+
+	growthHistoryResponse, err := client.GetGrowthHistory("list_id", &mailchimp.GrowthHistoryParams{
+		PaginationParams: mailchimp.PaginationParams{Count: 12},
+		SortDir:          "DESC",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 30, growthHistoryResponse.TotalItems)
+	history := growthHistoryResponse.History[0]
+	assert.Equal(t, "2019-04", history.Month)
+	assert.Equal(t, 85, history.Optins)
+	assert.Equal(t, 12, history.Unsubscribed)
+}
+
+func TestGetGrowthHistoryMonth(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/growth-history/2019-04", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, growthHistoryResponse)
+	})
+	defer server.Close()
+
+	history, err := client.GetGrowthHistoryMonth("list_id", "2019-04")
+	assert.NoError(t, err)
+	assert.Equal(t, 1310, history.Subscribed)
+}
@@ -0,0 +1,123 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// InterestCategoryType - how an interest category is displayed on signup forms
+type InterestCategoryType string
+
+// Interest category types
+const (
+	InterestCategoryCheckboxes InterestCategoryType = "checkboxes"
+	InterestCategoryDropdown   InterestCategoryType = "dropdown"
+	InterestCategoryRadio      InterestCategoryType = "radio"
+	InterestCategoryHidden     InterestCategoryType = "hidden"
+)
+
+// InterestCategory - see https://developer.mailchimp.com/documentation/mailchimp/reference/lists/interest-categories/
+type InterestCategory struct {
+	ListID       string               `json:"list_id"`
+	ID           string               `json:"id"`
+	Title        string               `json:"title"`         // The text description of this category, shown on signup forms.
+	DisplayOrder int                  `json:"display_order"` // The order that the categories are displayed in the list. Lower numbers display first.
+	Type         InterestCategoryType `json:"type"`
+}
+
+// InterestCategoryParams - used both to create and to update an interest category
+type InterestCategoryParams struct {
+	Title        string               `json:"title"`
+	DisplayOrder int                  `json:"display_order,omitempty"`
+	Type         InterestCategoryType `json:"type"`
+}
+
+// ListInterestCategoriesParams ...
+type ListInterestCategoriesParams struct {
+	PaginationParams
+	Type InterestCategoryType // Restrict results to interest categories of this type.
+}
+
+// ListInterestCategoriesResponse ...
+type ListInterestCategoriesResponse struct {
+	Categories []InterestCategory `json:"categories"`
+	ListID     string             `json:"list_id"`
+	TotalItems int                `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// ListInterestCategories returns a page of the interest categories of a list
+func (c *Client) ListInterestCategories(listID string, params *ListInterestCategoriesParams) (*ListInterestCategoriesResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+		if params.Type != "" {
+			query.Set("type", string(params.Type))
+		}
+	}
+	listInterestCategoriesResponse := new(ListInterestCategoriesResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/lists/%s/interest-categories", listID), query),
+		nil,
+		listInterestCategoriesResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listInterestCategoriesResponse, nil
+}
+
+// GetInterestCategory returns a specific interest category of a list
+func (c *Client) GetInterestCategory(listID string, categoryID string) (*InterestCategory, error) {
+	interestCategory := new(InterestCategory)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/interest-categories/%s", listID, categoryID),
+		nil,
+		interestCategory,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return interestCategory, nil
+}
+
+// CreateInterestCategory adds a new interest category to a list
+func (c *Client) CreateInterestCategory(listID string, params *InterestCategoryParams) (*InterestCategory, error) {
+	interestCategory := new(InterestCategory)
+	err := c.request(
+		"POST",
+		fmt.Sprintf("/lists/%s/interest-categories", listID),
+		params,
+		interestCategory,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return interestCategory, nil
+}
+
+// UpdateInterestCategory updates a specific interest category of a list
+func (c *Client) UpdateInterestCategory(listID string, categoryID string, params *InterestCategoryParams) (*InterestCategory, error) {
+	interestCategory := new(InterestCategory)
+	err := c.request(
+		"PATCH",
+		fmt.Sprintf("/lists/%s/interest-categories/%s", listID, categoryID),
+		params,
+		interestCategory,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return interestCategory, nil
+}
+
+// DeleteInterestCategory deletes a specific interest category and its interests
+func (c *Client) DeleteInterestCategory(listID string, categoryID string) error {
+	return c.request(
+		"DELETE",
+		fmt.Sprintf("/lists/%s/interest-categories/%s", listID, categoryID),
+		nil,
+		nil,
+	)
+}
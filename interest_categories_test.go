@@ -0,0 +1,65 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListInterestCategories(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/interest-categories", req.URL.Path)
+		assert.Equal(t, "type=checkboxes", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"categories": [%s], "list_id": "list_id", "total_items": 1}`, interestCategoryResponse)
+	})
+	defer server.Close()
+
+	listInterestCategoriesResponse, err := client.ListInterestCategories("list_id", &mailchimp.ListInterestCategoriesParams{
+		Type: mailchimp.InterestCategoryCheckboxes,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, listInterestCategoriesResponse.TotalItems)
+	assert.Equal(t, "Product line", listInterestCategoriesResponse.Categories[0].Title)
+}
+
+func TestCreateInterestCategory(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists/list_id/interest-categories", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{"title": "Product line", "type": "checkboxes"}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, interestCategoryResponse)
+	})
+	defer server.Close()
+
+	interestCategory, err := client.CreateInterestCategory("list_id", &mailchimp.InterestCategoryParams{
+		Title: "Product line",
+		Type:  mailchimp.InterestCategoryCheckboxes,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "a1e9f4b7f6", interestCategory.ID)
+	assert.Equal(t, mailchimp.InterestCategoryCheckboxes, interestCategory.Type)
+}
+
+func TestDeleteInterestCategory(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/lists/list_id/interest-categories/a1e9f4b7f6", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.DeleteInterestCategory("list_id", "a1e9f4b7f6"))
+}
@@ -0,0 +1,132 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Interest - see https://developer.mailchimp.com/documentation/mailchimp/reference/lists/interest-categories/interests/
+type Interest struct {
+	CategoryID      string `json:"category_id"`
+	ListID          string `json:"list_id"`
+	ID              string `json:"id"` // The ID used as key of the member interests map.
+	Name            string `json:"name"`
+	SubscriberCount string `json:"subscriber_count"` // The number of subscribers associated with this interest.
+	DisplayOrder    int    `json:"display_order"`
+}
+
+// InterestParams - used both to create and to update an interest
+type InterestParams struct {
+	Name         string `json:"name"`
+	DisplayOrder int    `json:"display_order,omitempty"`
+}
+
+// ListInterestsResponse ...
+type ListInterestsResponse struct {
+	Interests  []Interest `json:"interests"`
+	ListID     string     `json:"list_id"`
+	CategoryID string     `json:"category_id"`
+	TotalItems int        `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// ListInterests returns a page of the interests of an interest category
+func (c *Client) ListInterests(listID string, categoryID string, params *PaginationParams) (*ListInterestsResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+	}
+	listInterestsResponse := new(ListInterestsResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/lists/%s/interest-categories/%s/interests", listID, categoryID), query),
+		nil,
+		listInterestsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listInterestsResponse, nil
+}
+
+// GetInterest returns a specific interest of an interest category
+func (c *Client) GetInterest(listID string, categoryID string, interestID string) (*Interest, error) {
+	interest := new(Interest)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/interest-categories/%s/interests/%s", listID, categoryID, interestID),
+		nil,
+		interest,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return interest, nil
+}
+
+// CreateInterest adds a new interest to an interest category
+func (c *Client) CreateInterest(listID string, categoryID string, params *InterestParams) (*Interest, error) {
+	interest := new(Interest)
+	err := c.request(
+		"POST",
+		fmt.Sprintf("/lists/%s/interest-categories/%s/interests", listID, categoryID),
+		params,
+		interest,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return interest, nil
+}
+
+// UpdateInterest updates a specific interest of an interest category
+func (c *Client) UpdateInterest(listID string, categoryID string, interestID string, params *InterestParams) (*Interest, error) {
+	interest := new(Interest)
+	err := c.request(
+		"PATCH",
+		fmt.Sprintf("/lists/%s/interest-categories/%s/interests/%s", listID, categoryID, interestID),
+		params,
+		interest,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return interest, nil
+}
+
+// DeleteInterest deletes a specific interest of an interest category
+func (c *Client) DeleteInterest(listID string, categoryID string, interestID string) error {
+	return c.request(
+		"DELETE",
+		fmt.Sprintf("/lists/%s/interest-categories/%s/interests/%s", listID, categoryID, interestID),
+		nil,
+		nil,
+	)
+}
+
+// EnsureInterest returns the ID of the interest called name, creating it in
+// the interest category if it does not exist yet. Names are matched case insensitively.
+func (c *Client) EnsureInterest(listID string, categoryID string, name string) (string, error) {
+	params := &PaginationParams{Count: maxCount}
+	for {
+		listInterestsResponse, err := c.ListInterests(listID, categoryID, params)
+		if err != nil {
+			return "", err
+		}
+		for _, interest := range listInterestsResponse.Interests {
+			if strings.EqualFold(interest.Name, name) {
+				return interest.ID, nil
+			}
+		}
+		params.Offset += len(listInterestsResponse.Interests)
+		if len(listInterestsResponse.Interests) == 0 || params.Offset >= listInterestsResponse.TotalItems {
+			break
+		}
+	}
+
+	interest, err := c.CreateInterest(listID, categoryID, &InterestParams{Name: name})
+	if err != nil {
+		return "", err
+	}
+	return interest.ID, nil
+}
@@ -0,0 +1,64 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateInterest(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists/list_id/interest-categories/a1e9f4b7f6/interests", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{"name": "Widgets"}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"category_id": "a1e9f4b7f6", "list_id": "list_id", "id": "9143cf3bd1", "name": "Widgets", "subscriber_count": "0", "display_order": 1}`)
+	})
+	defer server.Close()
+
+	interest, err := client.CreateInterest("list_id", "a1e9f4b7f6", &mailchimp.InterestParams{Name: "Widgets"})
+	assert.NoError(t, err)
+	assert.Equal(t, "9143cf3bd1", interest.ID)
+}
+
+func TestEnsureInterestExisting(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/interest-categories/a1e9f4b7f6/interests", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"interests": [{"id": "3a2a927344", "name": "Gadgets"}, {"id": "9143cf3bd1", "name": "Widgets"}], "total_items": 2}`)
+	})
+	defer server.Close()
+
+	interestID, err := client.EnsureInterest("list_id", "a1e9f4b7f6", "widgets")
+	assert.NoError(t, err)
+	assert.Equal(t, "9143cf3bd1", interestID)
+}
+
+func TestEnsureInterestCreates(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/lists/list_id/interest-categories/a1e9f4b7f6/interests", req.URL.Path)
+
+		rw.WriteHeader(200)
+		if req.Method == "GET" {
+			fmt.Fprint(rw, `{"interests": [{"id": "3a2a927344", "name": "Gadgets"}], "total_items": 1}`)
+			return
+		}
+		assert.Equal(t, "POST", req.Method)
+		fmt.Fprint(rw, `{"id": "9143cf3bd1", "name": "Widgets"}`)
+	})
+	defer server.Close()
+
+	interestID, err := client.EnsureInterest("list_id", "a1e9f4b7f6", "Widgets")
+	assert.NoError(t, err)
+	assert.Equal(t, "9143cf3bd1", interestID)
+}
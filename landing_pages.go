@@ -0,0 +1,152 @@
+package mailchimp
+
+import (
+	"fmt"
+)
+
+// Landing page types
+const (
+	LandingPageSignup  = "signup"
+	LandingPageProduct = "product"
+)
+
+// Landing page statuses
+const (
+	LandingPagePublished   = "published"
+	LandingPageUnpublished = "unpublished"
+	LandingPageDraft       = "draft"
+)
+
+// LandingPageTracking - the tracking settings of a landing page
+type LandingPageTracking struct {
+	TrackWithMailchimp             bool `json:"track_with_mailchimp"`              // Use cookies to track unique visitors and calculate conversion rate.
+	EnableRestrictedDataProcessing bool `json:"enable_restricted_data_processing"` // Use restricted data processing with Facebook and Google.
+}
+
+// LandingPage - see https://developer.mailchimp.com/documentation/mailchimp/reference/landing-pages/
+type LandingPage struct {
+	ID              string              `json:"id"`
+	WebID           int                 `json:"web_id"` // The ID used in the Mailchimp web application.
+	Name            string              `json:"name"`   // The name of the landing page, only shown in the Mailchimp web application.
+	Title           string              `json:"title"`  // The title shown in the browser's title bar.
+	Description     string              `json:"description"`
+	TemplateID      int                 `json:"template_id"`
+	Status          string              `json:"status"` // One of LandingPagePublished, LandingPageUnpublished or LandingPageDraft.
+	ListID          string              `json:"list_id"`
+	StoreID         string              `json:"store_id"`
+	URL             string              `json:"url"` // The URL of the published landing page.
+	CreatedAt       string              `json:"created_at"`
+	UpdatedAt       string              `json:"updated_at"`
+	PublishedAt     string              `json:"published_at"`
+	UnpublishedAt   string              `json:"unpublished_at"`
+	CreatedBySource string              `json:"created_by_source"`
+	Tracking        LandingPageTracking `json:"tracking"`
+}
+
+// LandingPageParams - see https://developer.mailchimp.com/documentation/mailchimp/reference/landing-pages/#create-post_landing_pages
+// Only non empty fields are sent, so fields left empty are not changed on update.
+type LandingPageParams struct {
+	Name        string               `json:"name,omitempty"`
+	Title       string               `json:"title,omitempty"`
+	Description string               `json:"description,omitempty"`
+	StoreID     string               `json:"store_id,omitempty"`
+	ListID      string               `json:"list_id,omitempty"`
+	Type        string               `json:"type,omitempty"`        // One of LandingPageSignup or LandingPageProduct, only used on create.
+	TemplateID  int                  `json:"template_id,omitempty"` // Only used on create.
+	Tracking    *LandingPageTracking `json:"tracking,omitempty"`
+}
+
+// LandingPageContent - the content of a landing page
+type LandingPageContent struct {
+	HTML string `json:"html"`
+	JSON string `json:"json"` // The landing page content as JSON, as used by the Mailchimp web application.
+}
+
+// CreateLandingPage creates a new landing page
+func (c *Client) CreateLandingPage(params *LandingPageParams) (*LandingPage, error) {
+	landingPage := new(LandingPage)
+	err := c.request(
+		"POST",
+		"/landing-pages",
+		params,
+		landingPage,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return landingPage, nil
+}
+
+// GetLandingPage returns information about a specific landing page
+func (c *Client) GetLandingPage(pageID string) (*LandingPage, error) {
+	landingPage := new(LandingPage)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/landing-pages/%s", pageID),
+		nil,
+		landingPage,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return landingPage, nil
+}
+
+// UpdateLandingPage updates the settings of a specific landing page
+func (c *Client) UpdateLandingPage(pageID string, params *LandingPageParams) (*LandingPage, error) {
+	landingPage := new(LandingPage)
+	err := c.request(
+		"PATCH",
+		fmt.Sprintf("/landing-pages/%s", pageID),
+		params,
+		landingPage,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return landingPage, nil
+}
+
+// DeleteLandingPage deletes a landing page
+func (c *Client) DeleteLandingPage(pageID string) error {
+	return c.request(
+		"DELETE",
+		fmt.Sprintf("/landing-pages/%s", pageID),
+		nil,
+		nil,
+	)
+}
+
+// PublishLandingPage publishes a landing page so it can be visited
+func (c *Client) PublishLandingPage(pageID string) error {
+	return c.landingPageAction(pageID, "publish")
+}
+
+// UnpublishLandingPage unpublishes a landing page so it can no longer be visited
+func (c *Client) UnpublishLandingPage(pageID string) error {
+	return c.landingPageAction(pageID, "unpublish")
+}
+
+// GetLandingPageContent returns the HTML and JSON content of a landing page
+func (c *Client) GetLandingPageContent(pageID string) (*LandingPageContent, error) {
+	landingPageContent := new(LandingPageContent)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/landing-pages/%s/content", pageID),
+		nil,
+		landingPageContent,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return landingPageContent, nil
+}
+
+func (c *Client) landingPageAction(pageID string, action string) error {
+	return c.request(
+		"POST",
+		fmt.Sprintf("/landing-pages/%s/actions/%s", pageID, action),
+		nil,
+		nil,
+	)
+}
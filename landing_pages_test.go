@@ -0,0 +1,129 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateLandingPage(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/landing-pages", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"name":        "Spring sale",
+			"title":       "Spring sale - 20% off",
+			"list_id":     "0f6b836652",
+			"type":        "signup",
+			"template_id": float64(1001),
+			"tracking": map[string]interface{}{
+				"track_with_mailchimp":              true,
+				"enable_restricted_data_processing": false,
+			},
+		}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, landingPageResponse)
+	})
+	defer server.Close()
+
+	landingPage, err := client.CreateLandingPage(&mailchimp.LandingPageParams{
+		Name:       "Spring sale",
+		Title:      "Spring sale - 20% off",
+		ListID:     "0f6b836652",
+		Type:       mailchimp.LandingPageSignup,
+		TemplateID: 1001,
+		Tracking:   &mailchimp.LandingPageTracking{TrackWithMailchimp: true},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "lp_0f6b8366", landingPage.ID)
+	assert.Equal(t, mailchimp.LandingPagePublished, landingPage.Status)
+}
+
+func TestGetLandingPage(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/landing-pages/lp_0f6b8366", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, landingPageResponse)
+	})
+	defer server.Close()
+
+	landingPage, err := client.GetLandingPage("lp_0f6b8366")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://mailchi.mp/example/spring-sale", landingPage.URL)
+	assert.Equal(t, 1001, landingPage.TemplateID)
+	assert.True(t, landingPage.Tracking.TrackWithMailchimp)
+}
+
+func TestUpdateLandingPage(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "/landing-pages/lp_0f6b8366", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{"title": "Spring sale - 25% off"}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, landingPageResponse)
+	})
+	defer server.Close()
+
+	_, err := client.UpdateLandingPage("lp_0f6b8366", &mailchimp.LandingPageParams{Title: "Spring sale - 25% off"})
+	assert.NoError(t, err)
+}
+
+func TestDeleteLandingPage(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/landing-pages/lp_0f6b8366", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.DeleteLandingPage("lp_0f6b8366"))
+}
+
+func TestPublishUnpublishLandingPage(t *testing.T) {
+	var paths []string
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		paths = append(paths, req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.PublishLandingPage("lp_0f6b8366"))
+	assert.NoError(t, client.UnpublishLandingPage("lp_0f6b8366"))
+	assert.Equal(t, []string{
+		"/landing-pages/lp_0f6b8366/actions/publish",
+		"/landing-pages/lp_0f6b8366/actions/unpublish",
+	}, paths)
+}
+
+func TestGetLandingPageContent(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/landing-pages/lp_0f6b8366/content", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"html": "<html><body>Spring sale</body></html>", "json": "{\"blocks\": []}"}`)
+	})
+	defer server.Close()
+
+	landingPageContent, err := client.GetLandingPageContent("lp_0f6b8366")
+	assert.NoError(t, err)
+	assert.Equal(t, "<html><body>Spring sale</body></html>", landingPageContent.HTML)
+	assert.Equal(t, `{"blocks": []}`, landingPageContent.JSON)
+}
@@ -0,0 +1,42 @@
+package mailchimp
+
+import (
+	"fmt"
+)
+
+// ListActivity - a day's worth of engagement on a list
+type ListActivity struct {
+	Day             string `json:"day"` // The date for the activity summary, formatted YYYY-MM-DD.
+	EmailsSent      int    `json:"emails_sent"`
+	UniqueOpens     int    `json:"unique_opens"`
+	RecipientClicks int    `json:"recipient_clicks"` // The number of clicks across all campaigns sent that day.
+	HardBounce      int    `json:"hard_bounce"`
+	SoftBounce      int    `json:"soft_bounce"`
+	Subs            int    `json:"subs"`          // The number of subscribes.
+	Unsubs          int    `json:"unsubs"`        // The number of unsubscribes.
+	OtherAdds       int    `json:"other_adds"`    // The number of subscribers added in the Mailchimp app.
+	OtherRemoves    int    `json:"other_removes"` // The number of subscribers removed in the Mailchimp app.
+}
+
+// ListActivityResponse ...
+type ListActivityResponse struct {
+	Activity   []ListActivity `json:"activity"`
+	ListID     string         `json:"list_id"`
+	TotalItems int            `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// GetListActivity returns up to the previous 180 days of daily
+// engagement for a list, most recent first
+func (c *Client) GetListActivity(listID string) (*ListActivityResponse, error) {
+	listActivityResponse := new(ListActivityResponse)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/activity", listID),
+		nil,
+		listActivityResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listActivityResponse, nil
+}
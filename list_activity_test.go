@@ -0,0 +1,47 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetListActivity(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/activity", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"activity": [
+				{
+					"day": "2019-04-02",
+					"emails_sent": 1200,
+					"unique_opens": 310,
+					"recipient_clicks": 42,
+					"hard_bounce": 2,
+					"soft_bounce": 5,
+					"subs": 9,
+					"unsubs": 3,
+					"other_adds": 1,
+					"other_removes": 0
+				}
+			],
+			"list_id": "list_id",
+			"total_items": 180
+		}`)
+	})
+	defer server.Close()
+
+	listActivityResponse, err := client.GetListActivity("list_id")
+	assert.NoError(t, err)
+	assert.Equal(t, 180, listActivityResponse.TotalItems)
+	activity := listActivityResponse.Activity[0]
+	assert.Equal(t, "2019-04-02", activity.Day)
+	assert.Equal(t, 310, activity.UniqueOpens)
+	assert.Equal(t, 42, activity.RecipientClicks)
+	assert.Equal(t, 9, activity.Subs)
+	assert.Equal(t, 3, activity.Unsubs)
+}
@@ -0,0 +1,34 @@
+package mailchimp
+
+import (
+	"fmt"
+)
+
+// EmailClient - an email client used by members of a list
+type EmailClient struct {
+	Client  string `json:"client"`  // The name of the email client.
+	Members int    `json:"members"` // The number of list members using the client.
+}
+
+// ListClientsResponse ...
+type ListClientsResponse struct {
+	Clients    []EmailClient `json:"clients"`
+	ListID     string        `json:"list_id"`
+	TotalItems int           `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// GetListClients returns the top email clients used by members of a list,
+// based on user-agent strings
+func (c *Client) GetListClients(listID string) (*ListClientsResponse, error) {
+	listClientsResponse := new(ListClientsResponse)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/clients", listID),
+		nil,
+		listClientsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listClientsResponse, nil
+}
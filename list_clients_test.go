@@ -0,0 +1,33 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetListClients(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/clients", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"clients": [
+				{"client": "Gmail", "members": 640},
+				{"client": "Apple Mail", "members": 215}
+			],
+			"list_id": "list_id",
+			"total_items": 2
+		}`)
+	})
+	defer server.Close()
+
+	listClientsResponse, err := client.GetListClients("list_id")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, listClientsResponse.TotalItems)
+	assert.Equal(t, "Gmail", listClientsResponse.Clients[0].Client)
+	assert.Equal(t, 215, listClientsResponse.Clients[1].Members)
+}
@@ -0,0 +1,36 @@
+package mailchimp
+
+import (
+	"fmt"
+)
+
+// ListLocation - the number of list members in a country
+type ListLocation struct {
+	Country string  `json:"country"`
+	CC      string  `json:"cc"`      // The ISO 3166 2 digit country code.
+	Percent float64 `json:"percent"` // The percent of list members in the country.
+	Total   int     `json:"total"`   // The total number of list members in the country.
+}
+
+// ListLocationsResponse ...
+type ListLocationsResponse struct {
+	Locations  []ListLocation `json:"locations"`
+	ListID     string         `json:"list_id"`
+	TotalItems int            `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// GetListLocations returns the countries list members are located in,
+// based on their IP address
+func (c *Client) GetListLocations(listID string) (*ListLocationsResponse, error) {
+	listLocationsResponse := new(ListLocationsResponse)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/locations", listID),
+		nil,
+		listLocationsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listLocationsResponse, nil
+}
@@ -0,0 +1,34 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetListLocations(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/locations", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"locations": [
+				{"country": "United States", "cc": "US", "percent": 71.5, "total": 858},
+				{"country": "Germany", "cc": "DE", "percent": 9.25, "total": 111}
+			],
+			"list_id": "list_id",
+			"total_items": 2
+		}`)
+	})
+	defer server.Close()
+
+	listLocationsResponse, err := client.GetListLocations("list_id")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, listLocationsResponse.TotalItems)
+	assert.Equal(t, "US", listLocationsResponse.Locations[0].CC)
+	assert.Equal(t, 71.5, listLocationsResponse.Locations[0].Percent)
+	assert.Equal(t, 111, listLocationsResponse.Locations[1].Total)
+}
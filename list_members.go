@@ -0,0 +1,71 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/RichardKnop/go-mailchimp/status"
+)
+
+// ListMembersParams - see https://developer.mailchimp.com/documentation/mailchimp/reference/lists/members/#read-get_lists_list_id_members
+type ListMembersParams struct {
+	PaginationParams
+	EmailType         string              // Restrict results to members with this email type ('html' or 'text').
+	Status            status.MemberStatus // Restrict results to members with this status.
+	SinceLastChanged  time.Time           // Restrict results to members whose information changed after this time.
+	BeforeLastChanged time.Time           // Restrict results to members whose information changed before this time.
+}
+
+// ListMembersResponse ...
+type ListMembersResponse struct {
+	Members    []MemberResponse `json:"members"`
+	ListID     string           `json:"list_id"`
+	TotalItems int              `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// ListMembers returns a page of list members matching params
+func (c *Client) ListMembers(listID string, params *ListMembersParams) (*ListMembersResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+		if params.EmailType != "" {
+			query.Set("email_type", params.EmailType)
+		}
+		if params.Status != "" {
+			query.Set("status", string(params.Status))
+		}
+		setTime(query, "since_last_changed", params.SinceLastChanged)
+		setTime(query, "before_last_changed", params.BeforeLastChanged)
+	}
+	listMembersResponse := new(ListMembersResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/lists/%s/members", listID), query),
+		nil,
+		listMembersResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listMembersResponse, nil
+}
+
+// ListArchivedMembers returns a page of archived list members
+func (c *Client) ListArchivedMembers(listID string, params *PaginationParams) (*ListMembersResponse, error) {
+	listMembersParams := &ListMembersParams{Status: status.Archived}
+	if params != nil {
+		listMembersParams.PaginationParams = *params
+	}
+	return c.ListMembers(listID, listMembersParams)
+}
+
+// ListCleanedMembers returns a page of cleaned list members, i.e. addresses
+// which hard bounced or repeatedly soft bounced
+func (c *Client) ListCleanedMembers(listID string, params *PaginationParams) (*ListMembersResponse, error) {
+	listMembersParams := &ListMembersParams{Status: status.Cleaned}
+	if params != nil {
+		listMembersParams.PaginationParams = *params
+	}
+	return c.ListMembers(listID, listMembersParams)
+}
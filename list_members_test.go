@@ -0,0 +1,91 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/RichardKnop/go-mailchimp/status"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListMembers(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/members", req.URL.Path)
+		assert.Equal(t, "count=2&offset=10&since_last_changed=2016-06-03T07%3A13%3A07%2B00%3A00&status=subscribed", req.URL.RawQuery)
+		assert.Equal(t, "2016-06-03T07:13:07+00:00", req.URL.Query().Get("since_last_changed"))
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, listMembersResponse)
+	})
+	defer server.Close()
+
+	listMembersResponse, err := client.ListMembers("list_id", &mailchimp.ListMembersParams{
+		PaginationParams: mailchimp.PaginationParams{Count: 2, Offset: 10},
+		Status:           status.Subscribed,
+		SinceLastChanged: time.Date(2016, 6, 3, 9, 13, 7, 0, time.FixedZone("CEST", 2*60*60)),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 42, listMembersResponse.TotalItems)
+	assert.Len(t, listMembersResponse.Members, 2)
+	assert.Equal(t, "harold@finch.com", listMembersResponse.Members[1].EmailAddress)
+}
+
+func TestListMembersWithoutParams(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, listMembersResponse)
+	})
+	defer server.Close()
+
+	_, err := client.ListMembers("list_id", nil)
+	assert.NoError(t, err)
+}
+
+func TestListMembersBeforeLastChanged(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "before_last_changed=2016-06-03T07%3A13%3A07%2B00%3A00", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, listMembersResponse)
+	})
+	defer server.Close()
+
+	_, err := client.ListMembers("list_id", &mailchimp.ListMembersParams{
+		BeforeLastChanged: time.Date(2016, 6, 3, 7, 13, 7, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+}
+
+func TestListArchivedMembers(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/lists/list_id/members", req.URL.Path)
+		assert.Equal(t, "count=100&status=archived", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, listMembersResponse)
+	})
+	defer server.Close()
+
+	_, err := client.ListArchivedMembers("list_id", &mailchimp.PaginationParams{Count: 100})
+	assert.NoError(t, err)
+}
+
+func TestListCleanedMembers(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/lists/list_id/members", req.URL.Path)
+		assert.Equal(t, "status=cleaned", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, listMembersResponse)
+	})
+	defer server.Close()
+
+	_, err := client.ListCleanedMembers("list_id", nil)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,143 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// MergeFieldType - the type of a list merge field
+type MergeFieldType string
+
+// Merge field types
+const (
+	MergeFieldText     MergeFieldType = "text"
+	MergeFieldNumber   MergeFieldType = "number"
+	MergeFieldAddress  MergeFieldType = "address"
+	MergeFieldPhone    MergeFieldType = "phone"
+	MergeFieldDate     MergeFieldType = "date"
+	MergeFieldURL      MergeFieldType = "url"
+	MergeFieldImageURL MergeFieldType = "imageurl"
+	MergeFieldRadio    MergeFieldType = "radio"
+	MergeFieldDropdown MergeFieldType = "dropdown"
+	MergeFieldBirthday MergeFieldType = "birthday"
+	MergeFieldZip      MergeFieldType = "zip"
+)
+
+// MergeFieldOptions - extra options depending on the merge field type
+type MergeFieldOptions struct {
+	DefaultCountry int      `json:"default_country,omitempty"` // In an address field, the default country code if none supplied.
+	PhoneFormat    string   `json:"phone_format,omitempty"`    // In a phone field, the phone number type: 'US' or 'International'.
+	DateFormat     string   `json:"date_format,omitempty"`     // In a date or birthday field, the format of the date.
+	Choices        []string `json:"choices,omitempty"`         // In a radio or dropdown field, the available options.
+	Size           int      `json:"size,omitempty"`            // In a text field, the default length of the text field.
+}
+
+// MergeField - see https://developer.mailchimp.com/documentation/mailchimp/reference/lists/merge-fields/
+type MergeField struct {
+	MergeID      int               `json:"merge_id"`
+	Tag          string            `json:"tag"` // The tag used in Mailchimp campaigns and for the /members endpoint, e.g. FNAME.
+	Name         string            `json:"name"`
+	Type         MergeFieldType    `json:"type"`
+	Required     bool              `json:"required"`
+	DefaultValue string            `json:"default_value"`
+	Public       bool              `json:"public"` // Whether the merge field is displayed on the signup form.
+	DisplayOrder int               `json:"display_order"`
+	Options      MergeFieldOptions `json:"options"`
+	HelpText     string            `json:"help_text"` // Extra text to help the subscriber fill out the form.
+	ListID       string            `json:"list_id"`
+}
+
+// MergeFieldParams - used both to create and to update a merge field.
+// When updating, fields left empty are not changed, and the type cannot be changed.
+type MergeFieldParams struct {
+	Tag          string             `json:"tag,omitempty"` // Generated from the name if empty.
+	Name         string             `json:"name"`
+	Type         MergeFieldType     `json:"type,omitempty"`
+	Required     *bool              `json:"required,omitempty"`
+	DefaultValue string             `json:"default_value,omitempty"`
+	Public       *bool              `json:"public,omitempty"`
+	DisplayOrder int                `json:"display_order,omitempty"`
+	Options      *MergeFieldOptions `json:"options,omitempty"`
+	HelpText     string             `json:"help_text,omitempty"`
+}
+
+// ListMergeFieldsResponse ...
+type ListMergeFieldsResponse struct {
+	MergeFields []MergeField `json:"merge_fields"`
+	ListID      string       `json:"list_id"`
+	TotalItems  int          `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// ListMergeFields returns a page of the merge fields of a list
+func (c *Client) ListMergeFields(listID string, params *PaginationParams) (*ListMergeFieldsResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+	}
+	listMergeFieldsResponse := new(ListMergeFieldsResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/lists/%s/merge-fields", listID), query),
+		nil,
+		listMergeFieldsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listMergeFieldsResponse, nil
+}
+
+// GetMergeField returns a specific merge field of a list
+func (c *Client) GetMergeField(listID string, mergeID int) (*MergeField, error) {
+	mergeField := new(MergeField)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/merge-fields/%d", listID, mergeID),
+		nil,
+		mergeField,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return mergeField, nil
+}
+
+// CreateMergeField adds a new merge field to a list
+func (c *Client) CreateMergeField(listID string, params *MergeFieldParams) (*MergeField, error) {
+	mergeField := new(MergeField)
+	err := c.request(
+		"POST",
+		fmt.Sprintf("/lists/%s/merge-fields", listID),
+		params,
+		mergeField,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return mergeField, nil
+}
+
+// UpdateMergeField updates a specific merge field of a list
+func (c *Client) UpdateMergeField(listID string, mergeID int, params *MergeFieldParams) (*MergeField, error) {
+	mergeField := new(MergeField)
+	err := c.request(
+		"PATCH",
+		fmt.Sprintf("/lists/%s/merge-fields/%d", listID, mergeID),
+		params,
+		mergeField,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return mergeField, nil
+}
+
+// DeleteMergeField deletes a specific merge field of a list
+func (c *Client) DeleteMergeField(listID string, mergeID int) error {
+	return c.request(
+		"DELETE",
+		fmt.Sprintf("/lists/%s/merge-fields/%d", listID, mergeID),
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,89 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListMergeFields(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/merge-fields", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"merge_fields": [%s], "list_id": "list_id", "total_items": 1}`, mergeFieldResponse)
+	})
+	defer server.Close()
+
+	listMergeFieldsResponse, err := client.ListMergeFields("list_id", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, listMergeFieldsResponse.TotalItems)
+	assert.Equal(t, mailchimp.MergeFieldDropdown, listMergeFieldsResponse.MergeFields[0].Type)
+	assert.Equal(t, []string{"free", "pro"}, listMergeFieldsResponse.MergeFields[0].Options.Choices)
+}
+
+func TestCreateMergeField(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists/list_id/merge-fields", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"tag":           "PLAN",
+			"name":          "Plan",
+			"type":          "dropdown",
+			"default_value": "free",
+			"public":        true,
+			"options":       map[string]interface{}{"choices": []interface{}{"free", "pro"}},
+		}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, mergeFieldResponse)
+	})
+	defer server.Close()
+
+	public := true
+	mergeField, err := client.CreateMergeField("list_id", &mailchimp.MergeFieldParams{
+		Tag:          "PLAN",
+		Name:         "Plan",
+		Type:         mailchimp.MergeFieldDropdown,
+		DefaultValue: "free",
+		Public:       &public,
+		Options:      &mailchimp.MergeFieldOptions{Choices: []string{"free", "pro"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, mergeField.MergeID)
+	assert.Equal(t, "PLAN", mergeField.Tag)
+}
+
+func TestUpdateMergeField(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "/lists/list_id/merge-fields/5", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, mergeFieldResponse)
+	})
+	defer server.Close()
+
+	_, err := client.UpdateMergeField("list_id", 5, &mailchimp.MergeFieldParams{Name: "Plan"})
+	assert.NoError(t, err)
+}
+
+func TestDeleteMergeField(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/lists/list_id/merge-fields/5", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.DeleteMergeField("list_id", 5))
+}
@@ -0,0 +1,207 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// ListContact - contact information displayed in campaign footers to comply with international spam laws
+type ListContact struct {
+	Company  string `json:"company"`
+	Address1 string `json:"address1"`
+	Address2 string `json:"address2,omitempty"`
+	City     string `json:"city"`
+	State    string `json:"state"`
+	Zip      string `json:"zip"`
+	Country  string `json:"country"` // A two-character ISO3166 country code. Defaults to US if invalid.
+	Phone    string `json:"phone,omitempty"`
+}
+
+// CampaignDefaults - default values for campaigns created for this list
+type CampaignDefaults struct {
+	FromName  string `json:"from_name"`
+	FromEmail string `json:"from_email"`
+	Subject   string `json:"subject"`
+	Language  string `json:"language"`
+}
+
+// CreateListParams - see https://developer.mailchimp.com/documentation/mailchimp/reference/lists/#create-post_lists
+type CreateListParams struct {
+	Name                 string           `json:"name"`
+	Contact              ListContact      `json:"contact"`
+	PermissionReminder   string           `json:"permission_reminder"` // The permission reminder for the list.
+	UseArchiveBar        bool             `json:"use_archive_bar,omitempty"`
+	CampaignDefaults     CampaignDefaults `json:"campaign_defaults"`
+	NotifyOnSubscribe    string           `json:"notify_on_subscribe,omitempty"`   // The email address to send subscribe notifications to.
+	NotifyOnUnsubscribe  string           `json:"notify_on_unsubscribe,omitempty"` // The email address to send unsubscribe notifications to.
+	EmailTypeOption      bool             `json:"email_type_option"`               // Whether the list supports multiple formats for emails.
+	Visibility           string           `json:"visibility,omitempty"`            // Whether this list is public or private ('pub' or 'prv').
+	DoubleOptin          bool             `json:"double_optin,omitempty"`          // Whether or not to require the subscriber to confirm subscription via email.
+	MarketingPermissions bool             `json:"marketing_permissions,omitempty"` // Whether or not the list has marketing permissions (eg. GDPR) enabled.
+}
+
+// UpdateListParams - see https://developer.mailchimp.com/documentation/mailchimp/reference/lists/#edit-patch_lists_list_id
+// Only non empty fields are sent, so fields left empty are not changed.
+type UpdateListParams struct {
+	Name                 string            `json:"name,omitempty"`
+	Contact              *ListContact      `json:"contact,omitempty"`
+	PermissionReminder   string            `json:"permission_reminder,omitempty"`
+	UseArchiveBar        *bool             `json:"use_archive_bar,omitempty"`
+	CampaignDefaults     *CampaignDefaults `json:"campaign_defaults,omitempty"`
+	NotifyOnSubscribe    string            `json:"notify_on_subscribe,omitempty"`
+	NotifyOnUnsubscribe  string            `json:"notify_on_unsubscribe,omitempty"`
+	EmailTypeOption      *bool             `json:"email_type_option,omitempty"`
+	Visibility           string            `json:"visibility,omitempty"`
+	DoubleOptin          *bool             `json:"double_optin,omitempty"`
+	MarketingPermissions *bool             `json:"marketing_permissions,omitempty"`
+}
+
+// ListResponse - see https://api.mailchimp.com/schema/3.0/Lists/Instance.json
+type ListResponse struct {
+	ID                   string           `json:"id"`
+	WebID                int              `json:"web_id"` // The ID used in the Mailchimp web application.
+	Name                 string           `json:"name"`
+	Contact              ListContact      `json:"contact"`
+	PermissionReminder   string           `json:"permission_reminder"`
+	UseArchiveBar        bool             `json:"use_archive_bar"`
+	CampaignDefaults     CampaignDefaults `json:"campaign_defaults"`
+	NotifyOnSubscribe    string           `json:"notify_on_subscribe"`
+	NotifyOnUnsubscribe  string           `json:"notify_on_unsubscribe"`
+	DateCreated          string           `json:"date_created"` // The date and time that this list was created.
+	ListRating           int              `json:"list_rating"`  // An auto-generated activity score for the list (0-5).
+	EmailTypeOption      bool             `json:"email_type_option"`
+	SubscribeURLShort    string           `json:"subscribe_url_short"` // Our EepURL shortened version of this list's subscribe form.
+	SubscribeURLLong     string           `json:"subscribe_url_long"`  // The full version of this list's subscribe form.
+	BeamerAddress        string           `json:"beamer_address"`      // The list's Email Beamer address.
+	Visibility           string           `json:"visibility"`
+	DoubleOptin          bool             `json:"double_optin"`
+	MarketingPermissions bool             `json:"marketing_permissions"`
+	Modules              []string         `json:"modules"` // Any list-specific modules installed for this list.
+	Stats                ListStats        `json:"stats"`
+}
+
+// ListStats - stats for a list, some of which are cached by Mailchimp for up to a day
+type ListStats struct {
+	MemberCount               int     `json:"member_count"`                 // The number of active members in the list.
+	UnsubscribeCount          int     `json:"unsubscribe_count"`            // The number of members who have unsubscribed from the list.
+	CleanedCount              int     `json:"cleaned_count"`                // The number of members cleaned from the list.
+	MemberCountSinceSend      int     `json:"member_count_since_send"`      // The number of active members since the last campaign was sent.
+	UnsubscribeCountSinceSend int     `json:"unsubscribe_count_since_send"` // The number of members who have unsubscribed since the last campaign was sent.
+	CleanedCountSinceSend     int     `json:"cleaned_count_since_send"`     // The number of members cleaned since the last campaign was sent.
+	CampaignCount             int     `json:"campaign_count"`               // The number of campaigns in any status that use this list.
+	CampaignLastSent          string  `json:"campaign_last_sent"`           // The date and time the last campaign was sent to this list.
+	MergeFieldCount           int     `json:"merge_field_count"`            // The number of merge vars for this list (not EMAIL, which is required).
+	AvgSubRate                float64 `json:"avg_sub_rate"`                 // The average number of subscriptions per month for the list.
+	AvgUnsubRate              float64 `json:"avg_unsub_rate"`               // The average number of unsubscriptions per month for the list.
+	TargetSubRate             float64 `json:"target_sub_rate"`              // The target number of subscriptions per month for the list to keep it growing.
+	OpenRate                  float64 `json:"open_rate"`                    // The average open rate (a percentage) for campaigns sent to this list.
+	ClickRate                 float64 `json:"click_rate"`                   // The average click rate (a percentage) for campaigns sent to this list.
+	LastSubDate               string  `json:"last_sub_date"`                // The date and time of the last time someone subscribed to this list.
+	LastUnsubDate             string  `json:"last_unsub_date"`              // The date and time of the last time someone unsubscribed from this list.
+}
+
+// ListListsParams ...
+type ListListsParams struct {
+	PaginationParams
+	FieldsParams
+}
+
+// ListListsResponse ...
+type ListListsResponse struct {
+	Lists      []ListResponse `json:"lists"`
+	TotalItems int            `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// CreateList creates a new list (audience)
+func (c *Client) CreateList(params *CreateListParams) (*ListResponse, error) {
+	listResponse := new(ListResponse)
+	err := c.request(
+		"POST",
+		"/lists",
+		params,
+		listResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listResponse, nil
+}
+
+// GetList returns information about a specific list
+func (c *Client) GetList(listID string, params *FieldsParams) (*ListResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+	}
+	listResponse := new(ListResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/lists/%s", listID), query),
+		nil,
+		listResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listResponse, nil
+}
+
+// GetListStats returns only the stats of a specific list, which keeps the
+// response small for callers polling frequently
+func (c *Client) GetListStats(listID string) (*ListStats, error) {
+	listResponse, err := c.GetList(listID, &FieldsParams{Fields: []string{"stats"}})
+	if err != nil {
+		return nil, err
+	}
+	return &listResponse.Stats, nil
+}
+
+// ListLists returns a page of the lists in the account
+func (c *Client) ListLists(params *ListListsParams) (*ListListsResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.PaginationParams.addTo(query)
+		params.FieldsParams.addTo(query)
+	}
+	listListsResponse := new(ListListsResponse)
+	err := c.request(
+		"GET",
+		withQuery("/lists", query),
+		nil,
+		listListsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listListsResponse, nil
+}
+
+// UpdateList updates the settings of a specific list
+func (c *Client) UpdateList(listID string, params *UpdateListParams) (*ListResponse, error) {
+	listResponse := new(ListResponse)
+	err := c.request(
+		"PATCH",
+		fmt.Sprintf("/lists/%s", listID),
+		params,
+		listResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listResponse, nil
+}
+
+// DeleteList deletes a list and all its members, reports and campaign
+// statistics. This cannot be undone, so confirm must be true or
+// ErrDeleteListNotConfirmed is returned without calling the API.
+func (c *Client) DeleteList(listID string, confirm bool) error {
+	if !confirm {
+		return ErrDeleteListNotConfirmed
+	}
+	return c.request(
+		"DELETE",
+		fmt.Sprintf("/lists/%s", listID),
+		nil,
+		nil,
+	)
+}
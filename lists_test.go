@@ -0,0 +1,159 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateList(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, "Customers", params["name"])
+		assert.Equal(t, "You signed up on our website.", params["permission_reminder"])
+		assert.Equal(t, "Samaritan", params["contact"].(map[string]interface{})["company"])
+		assert.Equal(t, "harold@finch.com", params["campaign_defaults"].(map[string]interface{})["from_email"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, listResponse)
+	})
+	defer server.Close()
+
+	listResponse, err := client.CreateList(&mailchimp.CreateListParams{
+		Name: "Customers",
+		Contact: mailchimp.ListContact{
+			Company:  "Samaritan",
+			Address1: "1 Main St",
+			City:     "New York",
+			State:    "NY",
+			Zip:      "10001",
+			Country:  "US",
+		},
+		PermissionReminder: "You signed up on our website.",
+		CampaignDefaults: mailchimp.CampaignDefaults{
+			FromName:  "Harold Finch",
+			FromEmail: "harold@finch.com",
+			Language:  "en",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "0f6b836652", listResponse.ID)
+	assert.Equal(t, "Customers", listResponse.Name)
+	assert.Equal(t, "New York", listResponse.Contact.City)
+}
+
+func TestGetList(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/0f6b836652", req.URL.Path)
+		assert.Equal(t, "exclude_fields=_links", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, listResponse)
+	})
+	defer server.Close()
+
+	listResponse, err := client.GetList("0f6b836652", &mailchimp.FieldsParams{ExcludeFields: []string{"_links"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Customers", listResponse.Name)
+	assert.Equal(t, 42, listResponse.Stats.MemberCount)
+	assert.Equal(t, 25.5, listResponse.Stats.OpenRate)
+}
+
+func TestGetListStats(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/0f6b836652", req.URL.Path)
+		assert.Equal(t, "fields=stats", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"stats": {"member_count": 42, "unsubscribe_count": 3, "open_rate": 25.5, "click_rate": 4.2}}`)
+	})
+	defer server.Close()
+
+	listStats, err := client.GetListStats("0f6b836652")
+	assert.NoError(t, err)
+	assert.Equal(t, 42, listStats.MemberCount)
+	assert.Equal(t, 3, listStats.UnsubscribeCount)
+	assert.Equal(t, 4.2, listStats.ClickRate)
+}
+
+func TestListLists(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists", req.URL.Path)
+		assert.Equal(t, "count=20&fields=lists.id%2Clists.name%2Ctotal_items&offset=20", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"lists": [%s], "total_items": 21}`, listResponse)
+	})
+	defer server.Close()
+
+	listListsResponse, err := client.ListLists(&mailchimp.ListListsParams{
+		PaginationParams: mailchimp.PaginationParams{Count: 20, Offset: 20},
+		FieldsParams:     mailchimp.FieldsParams{Fields: []string{"lists.id", "lists.name", "total_items"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 21, listListsResponse.TotalItems)
+	assert.Equal(t, "0f6b836652", listListsResponse.Lists[0].ID)
+}
+
+func TestUpdateList(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "/lists/0f6b836652", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"name": "Customers",
+			"campaign_defaults": map[string]interface{}{
+				"from_name":  "Harold Finch",
+				"from_email": "harold@finch.com",
+				"subject":    "",
+				"language":   "en",
+			},
+		}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, listResponse)
+	})
+	defer server.Close()
+
+	listResponse, err := client.UpdateList("0f6b836652", &mailchimp.UpdateListParams{
+		Name: "Customers",
+		CampaignDefaults: &mailchimp.CampaignDefaults{
+			FromName:  "Harold Finch",
+			FromEmail: "harold@finch.com",
+			Language:  "en",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Harold Finch", listResponse.CampaignDefaults.FromName)
+}
+
+func TestDeleteList(t *testing.T) {
+	requests := 0
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/lists/0f6b836652", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.Equal(t, mailchimp.ErrDeleteListNotConfirmed, client.DeleteList("0f6b836652", false))
+	assert.Equal(t, 0, requests)
+
+	assert.NoError(t, client.DeleteList("0f6b836652", true))
+	assert.Equal(t, 1, requests)
+}
@@ -0,0 +1,25 @@
+package mailchimp
+
+// MarketingPermission - GDPR consent for a single marketing channel (e.g. email or ads)
+type MarketingPermission struct {
+	MarketingPermissionID string `json:"marketing_permission_id"`
+	Text                  string `json:"text,omitempty"` // The text of the marketing permission. Read only.
+	Enabled               bool   `json:"enabled"`        // If the subscriber has opted-in to the marketing permission.
+}
+
+// GetListMarketingPermissions returns the marketing permissions configured for a list.
+// Mailchimp has no dedicated endpoint for them, so they are read from a list member,
+// which means the list must have at least one member. The Enabled flag of the
+// returned permissions is that member's consent and should be ignored.
+func (c *Client) GetListMarketingPermissions(listID string) ([]MarketingPermission, error) {
+	listMembersResponse, err := c.ListMembers(listID, &ListMembersParams{
+		PaginationParams: PaginationParams{Count: 1},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(listMembersResponse.Members) == 0 {
+		return []MarketingPermission{}, nil
+	}
+	return listMembersResponse.Members[0].MarketingPermissions, nil
+}
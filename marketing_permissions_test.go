@@ -0,0 +1,49 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetListMarketingPermissions(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/lists/list_id/members", req.URL.Path)
+		assert.Equal(t, "count=1", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, listMembersResponse)
+	})
+	defer server.Close()
+
+	permissions, err := client.GetListMarketingPermissions("list_id")
+	assert.NoError(t, err)
+	assert.Equal(t, []mailchimp.MarketingPermission{
+		{MarketingPermissionID: "a1b2c3", Text: "Email", Enabled: true},
+	}, permissions)
+}
+
+func TestSubscribeWithMarketingPermissions(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"marketing_permission_id": "a1b2c3", "enabled": true},
+		}, params["marketing_permissions"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, successResponse)
+	})
+	defer server.Close()
+
+	_, err := client.SubscribeWithOptions("list_id", "john@reese.com", &mailchimp.SubscribeParams{
+		MarketingPermissions: []mailchimp.MarketingPermission{
+			{MarketingPermissionID: "a1b2c3", Enabled: true},
+		},
+	})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,60 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Member activity types
+const (
+	ActivitySent   = "sent"
+	ActivityOpen   = "open"
+	ActivityClick  = "click"
+	ActivityBounce = "bounce"
+	ActivityUnsub  = "unsub"
+)
+
+// MemberActivity - see https://developer.mailchimp.com/documentation/mailchimp/reference/lists/members/activity-feed/
+type MemberActivity struct {
+	ActivityType       string `json:"activity_type"`        // The type of activity, e.g. ActivityOpen or ActivityClick.
+	CreatedAtTimestamp string `json:"created_at_timestamp"` // The date and time the activity happened.
+	CampaignID         string `json:"campaign_id"`          // The unique id for the campaign the activity relates to.
+	CampaignTitle      string `json:"campaign_title"`
+}
+
+// MemberActivityParams ...
+type MemberActivityParams struct {
+	PaginationParams
+	ActivityFilters []string // Restrict results to these activity types.
+}
+
+// MemberActivityResponse ...
+type MemberActivityResponse struct {
+	Activity   []MemberActivity `json:"activity"`
+	EmailID    string           `json:"email_id"`
+	ListID     string           `json:"list_id"`
+	TotalItems int              `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// GetMemberActivityFeed returns the engagement history of a list member, most recent first
+func (c *Client) GetMemberActivityFeed(listID string, email string, params *MemberActivityParams) (*MemberActivityResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+		if len(params.ActivityFilters) > 0 {
+			query.Set("activity_filters", strings.Join(params.ActivityFilters, ","))
+		}
+	}
+	memberActivityResponse := new(MemberActivityResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/lists/%s/members/%s/activity-feed", listID, SubscriberHash(email)), query),
+		nil,
+		memberActivityResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return memberActivityResponse, nil
+}
@@ -0,0 +1,38 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMemberActivityFeed(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769/activity-feed", req.URL.Path)
+		assert.Equal(t, "open,click", req.URL.Query().Get("activity_filters"))
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"activity": [
+				{"activity_type": "open", "created_at_timestamp": "2018-10-11T10:17:25+00:00", "campaign_id": "42694e9e57", "campaign_title": "October"},
+				{"activity_type": "click", "created_at_timestamp": "2018-10-11T10:18:01+00:00", "campaign_id": "42694e9e57", "campaign_title": "October"}
+			],
+			"email_id": "a12bef585f1cae41a46e7edd45ade769",
+			"list_id": "list_id",
+			"total_items": 2
+		}`)
+	})
+	defer server.Close()
+
+	memberActivityResponse, err := client.GetMemberActivityFeed("list_id", "john@reese.com", &mailchimp.MemberActivityParams{
+		ActivityFilters: []string{mailchimp.ActivityOpen, mailchimp.ActivityClick},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, memberActivityResponse.TotalItems)
+	assert.Equal(t, mailchimp.ActivityClick, memberActivityResponse.Activity[1].ActivityType)
+	assert.Equal(t, "2018-10-11T10:18:01+00:00", memberActivityResponse.Activity[1].CreatedAtTimestamp)
+}
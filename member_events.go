@@ -0,0 +1,55 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// MemberEvent - see https://developer.mailchimp.com/documentation/mailchimp/reference/lists/members/events/
+type MemberEvent struct {
+	Name       string            `json:"name"`        // The name for this type of event ('purchased', 'visited', etc).
+	Properties map[string]string `json:"properties"`  // Properties are available to use as merge tags in emails.
+	OccurredAt string            `json:"occurred_at"` // The date and time the event occurred.
+}
+
+// MemberEventsResponse ...
+type MemberEventsResponse struct {
+	Events     []MemberEvent `json:"events"`
+	TotalItems int           `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// CreateMemberEvent adds a custom event to a list member, which can be used
+// to trigger automations
+func (c *Client) CreateMemberEvent(listID string, email string, name string, properties map[string]string) error {
+	params := map[string]interface{}{
+		"name": name,
+	}
+	if properties != nil {
+		params["properties"] = properties
+	}
+	return c.request(
+		"POST",
+		fmt.Sprintf("/lists/%s/members/%s/events", listID, SubscriberHash(email)),
+		&params,
+		nil,
+	)
+}
+
+// ListMemberEvents returns a page of custom events of a list member
+func (c *Client) ListMemberEvents(listID string, email string, params *PaginationParams) (*MemberEventsResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+	}
+	memberEventsResponse := new(MemberEventsResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/lists/%s/members/%s/events", listID, SubscriberHash(email)), query),
+		nil,
+		memberEventsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return memberEventsResponse, nil
+}
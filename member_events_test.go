@@ -0,0 +1,47 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateMemberEvent(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769/events", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"name":       "purchased",
+			"properties": map[string]interface{}{"plan": "pro"},
+		}, params)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	err := client.CreateMemberEvent("list_id", "john@reese.com", "purchased", map[string]string{"plan": "pro"})
+	assert.NoError(t, err)
+}
+
+func TestListMemberEvents(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769/events", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"events": [{"name": "purchased", "properties": {"plan": "pro"}, "occurred_at": "2018-10-11T10:17:25+00:00"}], "total_items": 1}`)
+	})
+	defer server.Close()
+
+	memberEventsResponse, err := client.ListMemberEvents("list_id", "john@reese.com", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, memberEventsResponse.TotalItems)
+	assert.Equal(t, "purchased", memberEventsResponse.Events[0].Name)
+	assert.Equal(t, "pro", memberEventsResponse.Events[0].Properties["plan"])
+}
@@ -0,0 +1,36 @@
+package mailchimp
+
+import (
+	"fmt"
+)
+
+// MemberGoal - see https://developer.mailchimp.com/documentation/mailchimp/reference/lists/members/goals/
+type MemberGoal struct {
+	GoalID        int    `json:"goal_id"`
+	Event         string `json:"event"`           // The name/type of the goal event triggered.
+	LastVisitedAt string `json:"last_visited_at"` // The date and time the user last triggered this goal event.
+	Data          string `json:"data"`            // Any extra data passed with this goal event.
+}
+
+// MemberGoalsResponse ...
+type MemberGoalsResponse struct {
+	Goals      []MemberGoal `json:"goals"`
+	EmailID    string       `json:"email_id"`
+	ListID     string       `json:"list_id"`
+	TotalItems int          `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// GetMemberGoals returns the last 50 goal events of a list member
+func (c *Client) GetMemberGoals(listID string, email string) (*MemberGoalsResponse, error) {
+	memberGoalsResponse := new(MemberGoalsResponse)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/members/%s/goals", listID, SubscriberHash(email)),
+		nil,
+		memberGoalsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return memberGoalsResponse, nil
+}
@@ -0,0 +1,31 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMemberGoals(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769/goals", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"goals": [{"goal_id": 3, "event": "/pricing", "last_visited_at": "2018-10-11T10:17:25+00:00", "data": ""}],
+			"email_id": "a12bef585f1cae41a46e7edd45ade769",
+			"list_id": "list_id",
+			"total_items": 1
+		}`)
+	})
+	defer server.Close()
+
+	memberGoalsResponse, err := client.GetMemberGoals("list_id", "john@reese.com")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, memberGoalsResponse.TotalItems)
+	assert.Equal(t, 3, memberGoalsResponse.Goals[0].GoalID)
+	assert.Equal(t, "/pricing", memberGoalsResponse.Goals[0].Event)
+}
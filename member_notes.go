@@ -0,0 +1,105 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// MemberNote - see https://developer.mailchimp.com/documentation/mailchimp/reference/lists/members/notes/
+type MemberNote struct {
+	ID        int    `json:"id"`
+	CreatedAt string `json:"created_at"` // The date and time the note was created.
+	CreatedBy string `json:"created_by"` // The author of the note.
+	UpdatedAt string `json:"updated_at"` // The date and time the note was last updated.
+	Note      string `json:"note"`
+	ListID    string `json:"list_id"`
+	EmailID   string `json:"email_id"` // The MD5 hash of the lowercase version of the list member's email address.
+}
+
+// MemberNotesResponse ...
+type MemberNotesResponse struct {
+	Notes      []MemberNote `json:"notes"`
+	ListID     string       `json:"list_id"`
+	EmailID    string       `json:"email_id"`
+	TotalItems int          `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// CreateMemberNote adds a new note to a list member
+func (c *Client) CreateMemberNote(listID string, email string, note string) (*MemberNote, error) {
+	params := map[string]interface{}{
+		"note": note,
+	}
+	memberNote := new(MemberNote)
+	err := c.request(
+		"POST",
+		fmt.Sprintf("/lists/%s/members/%s/notes", listID, SubscriberHash(email)),
+		&params,
+		memberNote,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return memberNote, nil
+}
+
+// ListMemberNotes returns a page of notes for a list member
+func (c *Client) ListMemberNotes(listID string, email string, params *PaginationParams) (*MemberNotesResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+	}
+	memberNotesResponse := new(MemberNotesResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/lists/%s/members/%s/notes", listID, SubscriberHash(email)), query),
+		nil,
+		memberNotesResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return memberNotesResponse, nil
+}
+
+// GetMemberNote returns a specific note of a list member
+func (c *Client) GetMemberNote(listID string, email string, noteID int) (*MemberNote, error) {
+	memberNote := new(MemberNote)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/members/%s/notes/%d", listID, SubscriberHash(email), noteID),
+		nil,
+		memberNote,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return memberNote, nil
+}
+
+// UpdateMemberNote replaces the content of a note
+func (c *Client) UpdateMemberNote(listID string, email string, noteID int, note string) (*MemberNote, error) {
+	params := map[string]interface{}{
+		"note": note,
+	}
+	memberNote := new(MemberNote)
+	err := c.request(
+		"PATCH",
+		fmt.Sprintf("/lists/%s/members/%s/notes/%d", listID, SubscriberHash(email), noteID),
+		&params,
+		memberNote,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return memberNote, nil
+}
+
+// DeleteMemberNote deletes a note of a list member
+func (c *Client) DeleteMemberNote(listID string, email string, noteID int) error {
+	return c.request(
+		"DELETE",
+		fmt.Sprintf("/lists/%s/members/%s/notes/%d", listID, SubscriberHash(email), noteID),
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,74 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateMemberNote(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769/notes", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, "Called about billing", params["note"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, memberNoteResponse)
+	})
+	defer server.Close()
+
+	memberNote, err := client.CreateMemberNote("list_id", "john@reese.com", "Called about billing")
+	assert.NoError(t, err)
+	assert.Equal(t, 7, memberNote.ID)
+	assert.Equal(t, "Harold Finch", memberNote.CreatedBy)
+}
+
+func TestListMemberNotes(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769/notes", req.URL.Path)
+		assert.Equal(t, "count=5", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"notes": [%s], "total_items": 1}`, memberNoteResponse)
+	})
+	defer server.Close()
+
+	memberNotesResponse, err := client.ListMemberNotes("list_id", "john@reese.com", &mailchimp.PaginationParams{Count: 5})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, memberNotesResponse.TotalItems)
+	assert.Equal(t, "Called about billing", memberNotesResponse.Notes[0].Note)
+}
+
+func TestUpdateMemberNote(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769/notes/7", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, memberNoteResponse)
+	})
+	defer server.Close()
+
+	_, err := client.UpdateMemberNote("list_id", "john@reese.com", 7, "Called about billing")
+	assert.NoError(t, err)
+}
+
+func TestDeleteMemberNote(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769/notes/7", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.DeleteMemberNote("list_id", "john@reese.com", 7))
+}
@@ -0,0 +1,132 @@
+package mailchimp
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// TagActive adds the tag to the member
+	TagActive = "active"
+	// TagInactive removes the tag from the member
+	TagInactive = "inactive"
+)
+
+// MemberTagUpdate - a tag to add to or remove from a member
+type MemberTagUpdate struct {
+	Name   string `json:"name"`
+	Status string `json:"status"` // TagActive or TagInactive.
+}
+
+// MemberTagsResponse ...
+type MemberTagsResponse struct {
+	Tags       []MemberTag `json:"tags"`
+	TotalItems int         `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// UpdateMemberTags adds and removes tags on a list member
+func (c *Client) UpdateMemberTags(listID string, email string, tags []MemberTagUpdate) error {
+	params := map[string]interface{}{
+		"tags": tags,
+	}
+	return c.request(
+		"POST",
+		fmt.Sprintf("/lists/%s/members/%s/tags", listID, SubscriberHash(email)),
+		&params,
+		nil,
+	)
+}
+
+// GetMemberTags returns the tags applied to a list member
+func (c *Client) GetMemberTags(listID string, email string) (*MemberTagsResponse, error) {
+	memberTagsResponse := new(MemberTagsResponse)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/members/%s/tags", listID, SubscriberHash(email)),
+		nil,
+		memberTagsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return memberTagsResponse, nil
+}
+
+// ListMembersByTag returns all members tagged with tagName. Tag names are
+// matched case insensitively, and ErrTagNotFound is returned if the list has
+// no such tag.
+func (c *Client) ListMembersByTag(listID string, tagName string) ([]MemberResponse, error) {
+	segmentID, err := c.findTagSegmentID(listID, tagName)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []MemberResponse
+	params := &PaginationParams{Count: maxCount}
+	for {
+		listMembersResponse, err := c.ListSegmentMembers(listID, segmentID, params)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, listMembersResponse.Members...)
+		params.Offset += len(listMembersResponse.Members)
+		if len(listMembersResponse.Members) == 0 || params.Offset >= listMembersResponse.TotalItems {
+			return members, nil
+		}
+	}
+}
+
+// TagMembers applies the tag to all the emails, creating the tag if it does not
+// exist yet. Emails are added in chunks of MaxBatchMembers and the results of all
+// chunks are combined. If a chunk fails, the results of the chunks processed so
+// far are returned together with the error.
+func (c *Client) TagMembers(listID string, tagName string, emails []string) (*SegmentBatchResponse, error) {
+	segmentID, err := c.findTagSegmentID(listID, tagName)
+	if err == ErrTagNotFound {
+		segment, createErr := c.createStaticSegment(listID, tagName)
+		if createErr != nil {
+			return nil, createErr
+		}
+		segmentID, err = segment.ID, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	segmentBatchResponse := new(SegmentBatchResponse)
+	for start := 0; start < len(emails); start += MaxBatchMembers {
+		end := start + MaxBatchMembers
+		if end > len(emails) {
+			end = len(emails)
+		}
+		chunkResponse, err := c.BatchSegmentMembers(listID, segmentID, emails[start:end], []string{})
+		if err != nil {
+			return segmentBatchResponse, err
+		}
+		segmentBatchResponse.add(chunkResponse)
+	}
+	return segmentBatchResponse, nil
+}
+
+// findTagSegmentID returns the ID of the static segment backing a tag
+func (c *Client) findTagSegmentID(listID string, tagName string) (int, error) {
+	params := &ListSegmentsParams{
+		PaginationParams: PaginationParams{Count: maxCount},
+		Type:             SegmentStatic,
+	}
+	for {
+		listSegmentsResponse, err := c.ListSegments(listID, params)
+		if err != nil {
+			return 0, err
+		}
+		for _, segment := range listSegmentsResponse.Segments {
+			if strings.EqualFold(segment.Name, tagName) {
+				return segment.ID, nil
+			}
+		}
+		params.Offset += len(listSegmentsResponse.Segments)
+		if len(listSegmentsResponse.Segments) == 0 || params.Offset >= listSegmentsResponse.TotalItems {
+			return 0, ErrTagNotFound
+		}
+	}
+}
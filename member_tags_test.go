@@ -0,0 +1,138 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateMemberTags(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769/tags", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"tags": []interface{}{
+				map[string]interface{}{"name": "customer", "status": "active"},
+				map[string]interface{}{"name": "trial", "status": "inactive"},
+			},
+		}, params)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	err := client.UpdateMemberTags("list_id", "john@reese.com", []mailchimp.MemberTagUpdate{
+		{Name: "customer", Status: mailchimp.TagActive},
+		{Name: "trial", Status: mailchimp.TagInactive},
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetMemberTags(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769/tags", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"tags": [{"id": 17, "name": "customer", "date_added": "2018-10-11T10:17:25+00:00"}], "total_items": 1}`)
+	})
+	defer server.Close()
+
+	memberTagsResponse, err := client.GetMemberTags("list_id", "john@reese.com")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, memberTagsResponse.TotalItems)
+	assert.Equal(t, []mailchimp.MemberTag{
+		{ID: 17, Name: "customer", DateAdded: "2018-10-11T10:17:25+00:00"},
+	}, memberTagsResponse.Tags)
+}
+
+func TestListMembersByTag(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+
+		switch req.URL.Path {
+		case "/lists/list_id/segments":
+			assert.Equal(t, "count=1000&type=static", req.URL.RawQuery)
+			rw.WriteHeader(200)
+			fmt.Fprint(rw, `{"segments": [{"id": 11, "name": "trial", "type": "static"}, {"id": 17, "name": "Customer", "type": "static"}], "total_items": 2}`)
+		case "/lists/list_id/segments/17/members":
+			rw.WriteHeader(200)
+			if req.URL.Query().Get("offset") == "" {
+				fmt.Fprint(rw, `{"members": [{"email_address": "john@reese.com"}], "total_items": 2}`)
+				return
+			}
+			assert.Equal(t, "1", req.URL.Query().Get("offset"))
+			fmt.Fprint(rw, `{"members": [{"email_address": "harold@finch.com"}], "total_items": 2}`)
+		default:
+			t.Errorf("unexpected path %s", req.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	members, err := client.ListMembersByTag("list_id", "customer")
+	assert.NoError(t, err)
+	assert.Len(t, members, 2)
+	assert.Equal(t, "john@reese.com", members[0].EmailAddress)
+	assert.Equal(t, "harold@finch.com", members[1].EmailAddress)
+}
+
+func TestListMembersByTagNotFound(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"segments": [], "total_items": 0}`)
+	})
+	defer server.Close()
+
+	members, err := client.ListMembersByTag("list_id", "customer")
+	assert.Nil(t, members)
+	assert.Equal(t, mailchimp.ErrTagNotFound, err)
+}
+
+func TestTagMembers(t *testing.T) {
+	var chunkSizes []int
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == "GET" && req.URL.Path == "/lists/list_id/segments":
+			rw.WriteHeader(200)
+			fmt.Fprint(rw, `{"segments": [], "total_items": 0}`)
+		case req.Method == "POST" && req.URL.Path == "/lists/list_id/segments":
+			var params map[string]interface{}
+			assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+			assert.Equal(t, map[string]interface{}{"name": "launch", "static_segment": []interface{}{}}, params)
+
+			rw.WriteHeader(200)
+			fmt.Fprint(rw, `{"id": 23, "name": "launch", "type": "static"}`)
+		case req.Method == "POST" && req.URL.Path == "/lists/list_id/segments/23":
+			var params struct {
+				MembersToAdd    []string `json:"members_to_add"`
+				MembersToRemove []string `json:"members_to_remove"`
+			}
+			assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+			assert.Empty(t, params.MembersToRemove)
+			chunkSizes = append(chunkSizes, len(params.MembersToAdd))
+
+			rw.WriteHeader(200)
+			fmt.Fprintf(rw, `{"members_added": [], "members_removed": [], "errors": [], "total_added": %d, "total_removed": 0, "error_count": 0}`, len(params.MembersToAdd))
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	emails := make([]string, 700)
+	for i := range emails {
+		emails[i] = fmt.Sprintf("member%d@example.com", i)
+	}
+
+	segmentBatchResponse, err := client.TagMembers("list_id", "launch", emails)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{500, 200}, chunkSizes)
+	assert.Equal(t, 700, segmentBatchResponse.TotalAdded)
+}
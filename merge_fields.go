@@ -0,0 +1,111 @@
+package mailchimp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// mergeFieldTag is the struct tag mapping a struct field to a merge field, e.g.
+//
+//	type Contact struct {
+//		FirstName string `mailchimp:"FNAME"`
+//		LastName  string `mailchimp:"LNAME,omitempty"`
+//	}
+//
+// Fields without the tag, or tagged with "-", are ignored.
+const mergeFieldTag = "mailchimp"
+
+// MarshalMergeFields converts a struct (or pointer to struct) with mailchimp
+// struct tags into a merge fields map
+func MarshalMergeFields(v interface{}) (map[string]interface{}, error) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("mailchimp: cannot marshal merge fields from %T", v)
+	}
+
+	mergeFields := map[string]interface{}{}
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		name, omitEmpty, ok := parseMergeFieldTag(field)
+		if !ok {
+			continue
+		}
+		fieldValue := value.Field(i)
+		if omitEmpty && isEmptyValue(fieldValue) {
+			continue
+		}
+		mergeFields[name] = fieldValue.Interface()
+	}
+	return mergeFields, nil
+}
+
+// UnmarshalMergeFields populates the struct pointed to by v from a merge
+// fields map, such as MemberResponse.MergeFields
+func UnmarshalMergeFields(mergeFields map[string]interface{}, v interface{}) error {
+	ptr := reflect.ValueOf(v)
+	if ptr.Kind() != reflect.Ptr || ptr.IsNil() || ptr.Elem().Kind() != reflect.Struct {
+		return errors.New("mailchimp: UnmarshalMergeFields requires a non nil pointer to a struct")
+	}
+
+	value := ptr.Elem()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		name, _, ok := parseMergeFieldTag(field)
+		if !ok {
+			continue
+		}
+		mergeField, ok := mergeFields[name]
+		if !ok || mergeField == nil {
+			continue
+		}
+		// Round trip through JSON so values decoded from the API (e.g. float64
+		// numbers) convert to the field's type the same way a response would
+		data, err := json.Marshal(mergeField)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, value.Field(i).Addr().Interface()); err != nil {
+			return fmt.Errorf("mailchimp: cannot unmarshal merge field %s into %s: %v", name, field.Name, err)
+		}
+	}
+	return nil
+}
+
+func parseMergeFieldTag(field reflect.StructField) (name string, omitEmpty bool, ok bool) {
+	// Skip unexported fields
+	if field.PkgPath != "" {
+		return "", false, false
+	}
+	tag := field.Tag.Get(mergeFieldTag)
+	if tag == "" || tag == "-" {
+		return "", false, false
+	}
+	parts := strings.Split(tag, ",")
+	for _, option := range parts[1:] {
+		if option == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return parts[0], omitEmpty, true
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
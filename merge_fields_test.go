@@ -0,0 +1,47 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+type contact struct {
+	FirstName string `mailchimp:"FNAME"`
+	LastName  string `mailchimp:"LNAME,omitempty"`
+	Age       int    `mailchimp:"AGE,omitempty"`
+	Internal  string `mailchimp:"-"`
+	Untagged  string
+}
+
+func TestMarshalMergeFields(t *testing.T) {
+	mergeFields, err := mailchimp.MarshalMergeFields(&contact{
+		FirstName: "John",
+		Age:       42,
+		Internal:  "ignored",
+		Untagged:  "ignored",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"FNAME": "John",
+		"AGE":   42,
+	}, mergeFields)
+
+	_, err = mailchimp.MarshalMergeFields("bogus")
+	assert.Error(t, err)
+}
+
+func TestUnmarshalMergeFields(t *testing.T) {
+	// Decode like a response so numbers are float64
+	var mergeFields map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"FNAME": "John", "LNAME": "Reese", "AGE": 42, "MMERGE3": ""}`), &mergeFields))
+
+	c := new(contact)
+	assert.NoError(t, mailchimp.UnmarshalMergeFields(mergeFields, c))
+	assert.Equal(t, contact{FirstName: "John", LastName: "Reese", Age: 42}, *c)
+
+	assert.Error(t, mailchimp.UnmarshalMergeFields(mergeFields, contact{}))
+	assert.Error(t, mailchimp.UnmarshalMergeFields(map[string]interface{}{"AGE": "old"}, c))
+}
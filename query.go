@@ -0,0 +1,60 @@
+package mailchimp
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeFormat is the ISO 8601 format Mailchimp uses for date and time values
+const timeFormat = "2006-01-02T15:04:05-07:00"
+
+// maxCount is the largest page size Mailchimp allows
+const maxCount = 1000
+
+// PaginationParams - query parameters shared by collection endpoints
+type PaginationParams struct {
+	Count  int // The number of records to return. Mailchimp defaults to 10, maximum is 1000.
+	Offset int // The number of records from a collection to skip.
+}
+
+func (p PaginationParams) addTo(query url.Values) {
+	if p.Count > 0 {
+		query.Set("count", strconv.Itoa(p.Count))
+	}
+	if p.Offset > 0 {
+		query.Set("offset", strconv.Itoa(p.Offset))
+	}
+}
+
+// FieldsParams - query parameters restricting which fields are returned.
+// Fields of sub-objects are referenced with dot notation, e.g. stats.member_count.
+type FieldsParams struct {
+	Fields        []string // The fields to return.
+	ExcludeFields []string // The fields to exclude.
+}
+
+func (p FieldsParams) addTo(query url.Values) {
+	if len(p.Fields) > 0 {
+		query.Set("fields", strings.Join(p.Fields, ","))
+	}
+	if len(p.ExcludeFields) > 0 {
+		query.Set("exclude_fields", strings.Join(p.ExcludeFields, ","))
+	}
+}
+
+// setTime sets key to t in ISO 8601 format, unless t is the zero time
+func setTime(query url.Values, key string, t time.Time) {
+	if !t.IsZero() {
+		query.Set(key, t.UTC().Format(timeFormat))
+	}
+}
+
+// withQuery appends the encoded query string to path
+func withQuery(path string, query url.Values) string {
+	if len(query) == 0 {
+		return path
+	}
+	return path + "?" + query.Encode()
+}
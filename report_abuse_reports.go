@@ -0,0 +1,42 @@
+package mailchimp
+
+import (
+	"fmt"
+)
+
+// CampaignAbuseReportsResponse ...
+type CampaignAbuseReportsResponse struct {
+	AbuseReports []AbuseReport `json:"abuse_reports"`
+	CampaignID   string        `json:"campaign_id"`
+	TotalItems   int           `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// GetCampaignAbuseReports returns the abuse reports made against a campaign
+func (c *Client) GetCampaignAbuseReports(campaignID string) (*CampaignAbuseReportsResponse, error) {
+	campaignAbuseReportsResponse := new(CampaignAbuseReportsResponse)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/reports/%s/abuse-reports", campaignID),
+		nil,
+		campaignAbuseReportsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return campaignAbuseReportsResponse, nil
+}
+
+// GetCampaignAbuseReport returns a specific abuse report made against a campaign
+func (c *Client) GetCampaignAbuseReport(campaignID string, reportID int) (*AbuseReport, error) {
+	abuseReport := new(AbuseReport)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/reports/%s/abuse-reports/%d", campaignID, reportID),
+		nil,
+		abuseReport,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return abuseReport, nil
+}
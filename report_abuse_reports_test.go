@@ -0,0 +1,41 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCampaignAbuseReports(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/42694e9e57/abuse-reports", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"abuse_reports": [%s], "campaign_id": "42694e9e57", "total_items": 1}`, abuseReportResponse)
+	})
+	defer server.Close()
+
+	campaignAbuseReportsResponse, err := client.GetCampaignAbuseReports("42694e9e57")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, campaignAbuseReportsResponse.TotalItems)
+	assert.Equal(t, "john@reese.com", campaignAbuseReportsResponse.AbuseReports[0].EmailAddress)
+	assert.Equal(t, "list_id", campaignAbuseReportsResponse.AbuseReports[0].ListID)
+}
+
+func TestGetCampaignAbuseReport(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/42694e9e57/abuse-reports/8841", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, abuseReportResponse)
+	})
+	defer server.Close()
+
+	abuseReport, err := client.GetCampaignAbuseReport("42694e9e57", 8841)
+	assert.NoError(t, err)
+	assert.Equal(t, 8841, abuseReport.ID)
+}
@@ -0,0 +1,40 @@
+package mailchimp
+
+import (
+	"fmt"
+)
+
+// Advice types
+const (
+	AdviceNegative = "negative"
+	AdviceNeutral  = "neutral"
+	AdvicePositive = "positive"
+)
+
+// Advice - a piece of feedback on a campaign's performance
+type Advice struct {
+	Type    string `json:"type"`    // One of AdviceNegative, AdviceNeutral or AdvicePositive.
+	Message string `json:"message"` // The advice message, which may contain HTML.
+}
+
+// AdviceResponse ...
+type AdviceResponse struct {
+	Advice     []Advice `json:"advice"`
+	CampaignID string   `json:"campaign_id"`
+	TotalItems int      `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// GetAdvice returns Mailchimp's feedback on the performance of a campaign
+func (c *Client) GetAdvice(campaignID string) (*AdviceResponse, error) {
+	adviceResponse := new(AdviceResponse)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/reports/%s/advice", campaignID),
+		nil,
+		adviceResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return adviceResponse, nil
+}
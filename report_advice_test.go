@@ -0,0 +1,34 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAdvice(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/42694e9e57/advice", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"advice": [
+				{"type": "positive", "message": "This campaign's open rate was higher than your list average."},
+				{"type": "negative", "message": "This campaign had more unsubscribes than usual."}
+			],
+			"campaign_id": "42694e9e57",
+			"total_items": 2
+		}`)
+	})
+	defer server.Close()
+
+	adviceResponse, err := client.GetAdvice("42694e9e57")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, adviceResponse.TotalItems)
+	assert.Equal(t, mailchimp.AdvicePositive, adviceResponse.Advice[0].Type)
+	assert.Equal(t, "This campaign had more unsubscribes than usual.", adviceResponse.Advice[1].Message)
+}
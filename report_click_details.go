@@ -0,0 +1,84 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// URLClicked - the clicks on a link of a campaign
+type URLClicked struct {
+	ID                    string  `json:"id"` // The ID of the link.
+	URL                   string  `json:"url"`
+	TotalClicks           int     `json:"total_clicks"`
+	ClickPercentage       float64 `json:"click_percentage"` // The percentage of total clicks which were on this link.
+	UniqueClicks          int     `json:"unique_clicks"`
+	UniqueClickPercentage float64 `json:"unique_click_percentage"`
+	LastClick             string  `json:"last_click"`
+	CampaignID            string  `json:"campaign_id"`
+}
+
+// ClickDetailsResponse ...
+type ClickDetailsResponse struct {
+	URLsClicked []URLClicked `json:"urls_clicked"`
+	CampaignID  string       `json:"campaign_id"`
+	TotalItems  int          `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// ClickDetailsMember - a list member who clicked a link of a campaign
+type ClickDetailsMember struct {
+	EmailID       string                 `json:"email_id"` // The MD5 hash of the lowercase version of the member's email address.
+	EmailAddress  string                 `json:"email_address"`
+	MergeFields   map[string]interface{} `json:"merge_fields"`
+	VIP           bool                   `json:"vip"`
+	Clicks        int                    `json:"clicks"` // The number of times the member clicked the link.
+	ListID        string                 `json:"list_id"`
+	ListIsActive  bool                   `json:"list_is_active"`
+	ContactStatus string                 `json:"contact_status"`
+	CampaignID    string                 `json:"campaign_id"`
+	URLID         string                 `json:"url_id"`
+}
+
+// ClickDetailsMembersResponse ...
+type ClickDetailsMembersResponse struct {
+	Members    []ClickDetailsMember `json:"members"`
+	CampaignID string               `json:"campaign_id"`
+	TotalItems int                  `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// GetClickDetails returns a page of the links of a campaign with their clicks
+func (c *Client) GetClickDetails(campaignID string, params *PaginationParams) (*ClickDetailsResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+	}
+	clickDetailsResponse := new(ClickDetailsResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/reports/%s/click-details", campaignID), query),
+		nil,
+		clickDetailsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return clickDetailsResponse, nil
+}
+
+// GetClickDetailsMembers returns a page of the members who clicked a specific link of a campaign
+func (c *Client) GetClickDetailsMembers(campaignID string, linkID string, params *PaginationParams) (*ClickDetailsMembersResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+	}
+	clickDetailsMembersResponse := new(ClickDetailsMembersResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/reports/%s/click-details/%s/members", campaignID, linkID), query),
+		nil,
+		clickDetailsMembersResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return clickDetailsMembersResponse, nil
+}
@@ -0,0 +1,84 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetClickDetails(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/42694e9e57/click-details", req.URL.Path)
+		assert.Equal(t, "count=50", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"urls_clicked": [
+				{
+					"id": "d7a5b8c9e1",
+					"url": "https://example.com/pricing",
+					"total_clicks": 30,
+					"click_percentage": 0.46875,
+					"unique_clicks": 21,
+					"unique_click_percentage": 0.42,
+					"last_click": "2019-04-04T19:02:00+00:00",
+					"campaign_id": "42694e9e57"
+				}
+			],
+			"campaign_id": "42694e9e57",
+			"total_items": 1
+		}`)
+	})
+	defer server.Close()
+
+	clickDetailsResponse, err := client.GetClickDetails("42694e9e57", &mailchimp.PaginationParams{Count: 50})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, clickDetailsResponse.TotalItems)
+	urlClicked := clickDetailsResponse.URLsClicked[0]
+	assert.Equal(t, "https://example.com/pricing", urlClicked.URL)
+	assert.Equal(t, 21, urlClicked.UniqueClicks)
+}
+
+func TestGetClickDetailsMembers(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/42694e9e57/click-details/d7a5b8c9e1/members", req.URL.Path)
+		assert.Equal(t, "count=1000&offset=1000", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"members": [
+				{
+					"email_id": "a12bef585f1cae41a46e7edd45ade769",
+					"email_address": "john@reese.com",
+					"merge_fields": {"FNAME": "John"},
+					"vip": false,
+					"clicks": 3,
+					"list_id": "list_id",
+					"list_is_active": true,
+					"contact_status": "subscribed",
+					"campaign_id": "42694e9e57",
+					"url_id": "d7a5b8c9e1"
+				}
+			],
+			"campaign_id": "42694e9e57",
+			"total_items": 1001
+		}`)
+	})
+	defer server.Close()
+
+	clickDetailsMembersResponse, err := client.GetClickDetailsMembers(
+		"42694e9e57",
+		"d7a5b8c9e1",
+		&mailchimp.PaginationParams{Count: 1000, Offset: 1000},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 1001, clickDetailsMembersResponse.TotalItems)
+	member := clickDetailsMembersResponse.Members[0]
+	assert.Equal(t, "john@reese.com", member.EmailAddress)
+	assert.Equal(t, 3, member.Clicks)
+}
@@ -0,0 +1,45 @@
+package mailchimp
+
+import (
+	"fmt"
+)
+
+// DomainPerformance - the performance of a campaign for an email domain
+type DomainPerformance struct {
+	Domain     string  `json:"domain"` // e.g. gmail.com
+	EmailsSent int     `json:"emails_sent"`
+	Bounces    int     `json:"bounces"`
+	Opens      int     `json:"opens"`
+	Clicks     int     `json:"clicks"`
+	Unsubs     int     `json:"unsubs"`
+	Delivered  int     `json:"delivered"`
+	EmailsPct  float64 `json:"emails_pct"` // The percentage of the campaign's emails sent to the domain.
+	BouncesPct float64 `json:"bounces_pct"`
+	OpensPct   float64 `json:"opens_pct"`
+	ClicksPct  float64 `json:"clicks_pct"`
+	UnsubsPct  float64 `json:"unsubs_pct"`
+}
+
+// DomainPerformanceResponse ...
+type DomainPerformanceResponse struct {
+	Domains    []DomainPerformance `json:"domains"`
+	TotalSent  int                 `json:"total_sent"`
+	CampaignID string              `json:"campaign_id"`
+	TotalItems int                 `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// GetDomainPerformance returns the performance of a campaign for the top
+// email domains of its recipients
+func (c *Client) GetDomainPerformance(campaignID string) (*DomainPerformanceResponse, error) {
+	domainPerformanceResponse := new(DomainPerformanceResponse)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/reports/%s/domain-performance", campaignID),
+		nil,
+		domainPerformanceResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return domainPerformanceResponse, nil
+}
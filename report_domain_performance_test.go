@@ -0,0 +1,48 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDomainPerformance(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/42694e9e57/domain-performance", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"domains": [
+				{
+					"domain": "gmail.com",
+					"emails_sent": 640,
+					"bounces": 1,
+					"opens": 190,
+					"clicks": 30,
+					"unsubs": 2,
+					"delivered": 639,
+					"emails_pct": 53.33,
+					"bounces_pct": 0.16,
+					"opens_pct": 29.73,
+					"clicks_pct": 4.69,
+					"unsubs_pct": 0.31
+				}
+			],
+			"total_sent": 1200,
+			"campaign_id": "42694e9e57",
+			"total_items": 1
+		}`)
+	})
+	defer server.Close()
+
+	domainPerformanceResponse, err := client.GetDomainPerformance("42694e9e57")
+	assert.NoError(t, err)
+	assert.Equal(t, 1200, domainPerformanceResponse.TotalSent)
+	domain := domainPerformanceResponse.Domains[0]
+	assert.Equal(t, "gmail.com", domain.Domain)
+	assert.Equal(t, 29.73, domain.OpensPct)
+	assert.Equal(t, 639, domain.Delivered)
+}
@@ -0,0 +1,59 @@
+package mailchimp
+
+import (
+	"fmt"
+)
+
+// EepurlClickLocation - where clicks on the eepurl came from
+type EepurlClickLocation struct {
+	Country string `json:"country"` // The ISO 3166 2 digit country code.
+	Region  string `json:"region"`
+}
+
+// EepurlClicks - the clicks on the eepurl
+type EepurlClicks struct {
+	Clicks     int                   `json:"clicks"`
+	FirstClick string                `json:"first_click"`
+	LastClick  string                `json:"last_click"`
+	Locations  []EepurlClickLocation `json:"locations"`
+}
+
+// EepurlReferrer - a site which referred clicks to the eepurl
+type EepurlReferrer struct {
+	Referrer   string `json:"referrer"`
+	Clicks     int    `json:"clicks"`
+	FirstClick string `json:"first_click"`
+	LastClick  string `json:"last_click"`
+}
+
+// EepurlTwitter - the tweets mentioning the eepurl
+type EepurlTwitter struct {
+	Tweets     int    `json:"tweets"`
+	FirstTweet string `json:"first_tweet"`
+	LastTweet  string `json:"last_tweet"`
+	Retweets   int    `json:"retweets"`
+}
+
+// EepurlReport - the activity of the shortened URL of a campaign
+type EepurlReport struct {
+	Twitter    EepurlTwitter    `json:"twitter"`
+	Clicks     EepurlClicks     `json:"clicks"`
+	Referrers  []EepurlReferrer `json:"referrers"`
+	Eepurl     string           `json:"eepurl"` // The shortened URL of the campaign, e.g. http://eepurl.com/abc123
+	CampaignID string           `json:"campaign_id"`
+}
+
+// GetEepurlReport returns the activity of the shortened URL of a campaign
+func (c *Client) GetEepurlReport(campaignID string) (*EepurlReport, error) {
+	eepurlReport := new(EepurlReport)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/reports/%s/eepurl", campaignID),
+		nil,
+		eepurlReport,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return eepurlReport, nil
+}
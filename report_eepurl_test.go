@@ -0,0 +1,41 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEepurlReport(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/42694e9e57/eepurl", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"twitter": {"tweets": 2, "first_tweet": "", "last_tweet": "", "retweets": 1},
+			"clicks": {
+				"clicks": 18,
+				"first_click": "2019-04-02T14:00:00+00:00",
+				"last_click": "2019-04-06T10:00:00+00:00",
+				"locations": [{"country": "US", "region": "NY"}]
+			},
+			"referrers": [
+				{"referrer": "twitter.com", "clicks": 11, "first_click": "2019-04-02T14:00:00+00:00", "last_click": "2019-04-05T09:00:00+00:00"}
+			],
+			"eepurl": "http://eepurl.com/dxyz",
+			"campaign_id": "42694e9e57"
+		}`)
+	})
+	defer server.Close()
+
+	eepurlReport, err := client.GetEepurlReport("42694e9e57")
+	assert.NoError(t, err)
+	assert.Equal(t, "http://eepurl.com/dxyz", eepurlReport.Eepurl)
+	assert.Equal(t, 18, eepurlReport.Clicks.Clicks)
+	assert.Equal(t, "NY", eepurlReport.Clicks.Locations[0].Region)
+	assert.Equal(t, "twitter.com", eepurlReport.Referrers[0].Referrer)
+	assert.Equal(t, 1, eepurlReport.Twitter.Retweets)
+}
@@ -0,0 +1,76 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// EmailAction - a single interaction of a member with a campaign
+type EmailAction struct {
+	Action    string `json:"action"` // One of open, click or bounce.
+	Type      string `json:"type"`   // For bounces, either hard or soft.
+	Timestamp string `json:"timestamp"`
+	URL       string `json:"url"` // For clicks, the URL clicked.
+	IP        string `json:"ip"`
+}
+
+// EmailActivity - the interactions of a member with a campaign
+type EmailActivity struct {
+	CampaignID   string        `json:"campaign_id"`
+	ListID       string        `json:"list_id"`
+	ListIsActive bool          `json:"list_is_active"`
+	EmailID      string        `json:"email_id"` // The MD5 hash of the lowercase version of the member's email address.
+	EmailAddress string        `json:"email_address"`
+	Activity     []EmailAction `json:"activity"`
+}
+
+// EmailActivityParams ...
+type EmailActivityParams struct {
+	PaginationParams
+	Since time.Time // Restrict results to activity after the set time, for incremental ingestion.
+}
+
+// EmailActivityResponse ...
+type EmailActivityResponse struct {
+	Emails     []EmailActivity `json:"emails"`
+	CampaignID string          `json:"campaign_id"`
+	TotalItems int             `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// GetEmailActivity returns a page of the members' interactions with a campaign
+func (c *Client) GetEmailActivity(campaignID string, params *EmailActivityParams) (*EmailActivityResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+		setTime(query, "since", params.Since)
+	}
+	emailActivityResponse := new(EmailActivityResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/reports/%s/email-activity", campaignID), query),
+		nil,
+		emailActivityResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return emailActivityResponse, nil
+}
+
+// GetMemberEmailActivity returns the interactions of a specific member with a campaign
+func (c *Client) GetMemberEmailActivity(campaignID string, email string, since time.Time) (*EmailActivity, error) {
+	query := url.Values{}
+	setTime(query, "since", since)
+	emailActivity := new(EmailActivity)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/reports/%s/email-activity/%s", campaignID, SubscriberHash(email)), query),
+		nil,
+		emailActivity,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return emailActivity, nil
+}
@@ -0,0 +1,51 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEmailActivity(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/42694e9e57/email-activity", req.URL.Path)
+		assert.Equal(t, "count=1000&since=2019-04-03T00%3A00%3A00%2B00%3A00", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"emails": [%s], "campaign_id": "42694e9e57", "total_items": 1}`, emailActivityResponse)
+	})
+	defer server.Close()
+
+	emailActivityResponse, err := client.GetEmailActivity("42694e9e57", &mailchimp.EmailActivityParams{
+		PaginationParams: mailchimp.PaginationParams{Count: 1000},
+		Since:            time.Date(2019, 4, 3, 0, 0, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, emailActivityResponse.TotalItems)
+	activity := emailActivityResponse.Emails[0].Activity
+	assert.Len(t, activity, 2)
+	assert.Equal(t, "click", activity[1].Action)
+	assert.Equal(t, "https://example.com/pricing", activity[1].URL)
+}
+
+func TestGetMemberEmailActivity(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/42694e9e57/email-activity/a12bef585f1cae41a46e7edd45ade769", req.URL.Path)
+		assert.Equal(t, "", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, emailActivityResponse)
+	})
+	defer server.Close()
+
+	emailActivity, err := client.GetMemberEmailActivity("42694e9e57", "John@Reese.com", time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, "john@reese.com", emailActivity.EmailAddress)
+	assert.Equal(t, "open", emailActivity.Activity[0].Action)
+}
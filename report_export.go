@@ -0,0 +1,121 @@
+package mailchimp
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// ExportEmailActivityCSV writes the email activity report of a campaign to w
+// as CSV, with one row per action. All pages of the report are fetched.
+func (c *Client) ExportEmailActivityCSV(campaignID string, w io.Writer) error {
+	header := []string{"email_address", "action", "type", "timestamp", "url", "ip"}
+	return ExportCSV(w, header, func(offset int) ([][]string, int, int, error) {
+		emailActivityResponse, err := c.GetEmailActivity(campaignID, &EmailActivityParams{
+			PaginationParams: PaginationParams{Count: maxCount, Offset: offset},
+		})
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		var rows [][]string
+		for _, email := range emailActivityResponse.Emails {
+			for _, action := range email.Activity {
+				rows = append(rows, []string{
+					email.EmailAddress,
+					action.Action,
+					action.Type,
+					action.Timestamp,
+					action.URL,
+					action.IP,
+				})
+			}
+		}
+		return rows, len(emailActivityResponse.Emails), emailActivityResponse.TotalItems, nil
+	})
+}
+
+// ExportSentToCSV writes the sent-to report of a campaign to w as CSV, with
+// one row per recipient. All pages of the report are fetched.
+func (c *Client) ExportSentToCSV(campaignID string, w io.Writer) error {
+	header := []string{"email_address", "status", "open_count", "last_open", "absplit_group", "gmt_offset"}
+	return ExportCSV(w, header, func(offset int) ([][]string, int, int, error) {
+		sentToResponse, err := c.GetSentTo(campaignID, &PaginationParams{Count: maxCount, Offset: offset})
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		rows := make([][]string, len(sentToResponse.SentTo))
+		for i, sentTo := range sentToResponse.SentTo {
+			rows[i] = []string{
+				sentTo.EmailAddress,
+				sentTo.Status,
+				strconv.Itoa(sentTo.OpenCount),
+				sentTo.LastOpen,
+				sentTo.AbsplitGroup,
+				strconv.Itoa(sentTo.GMTOffset),
+			}
+		}
+		return rows, len(sentToResponse.SentTo), sentToResponse.TotalItems, nil
+	})
+}
+
+// ExportOpenDetailsCSV writes the open details report of a campaign to w as
+// CSV, with one row per open. All pages of the report are fetched.
+func (c *Client) ExportOpenDetailsCSV(campaignID string, w io.Writer) error {
+	header := []string{"email_address", "opens_count", "timestamp"}
+	return ExportCSV(w, header, func(offset int) ([][]string, int, int, error) {
+		openDetailsResponse, err := c.GetOpenDetails(campaignID, &OpenDetailsParams{
+			PaginationParams: PaginationParams{Count: maxCount, Offset: offset},
+		})
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		var rows [][]string
+		for _, member := range openDetailsResponse.Members {
+			for _, open := range member.Opens {
+				rows = append(rows, []string{
+					member.EmailAddress,
+					strconv.Itoa(member.OpensCount),
+					open.Timestamp,
+				})
+			}
+		}
+		return rows, len(openDetailsResponse.Members), openDetailsResponse.TotalItems, nil
+	})
+}
+
+// CSVPageFunc returns the CSV rows of the page of a report starting at offset,
+// along with the number of items on the page and the total number of items
+// in the report. A row need not map to a single item, e.g. one item may be
+// written as several rows or none.
+type CSVPageFunc func(offset int) (rows [][]string, items int, totalItems int, err error)
+
+// ExportCSV writes header and then the rows of every page returned by
+// fetchPage to w, so any paginated report can be exported as CSV. Rows
+// written before an error are still flushed to w.
+func ExportCSV(w io.Writer, header []string, fetchPage CSVPageFunc) (err error) {
+	csvWriter := csv.NewWriter(w)
+	defer func() {
+		csvWriter.Flush()
+		if err == nil {
+			err = csvWriter.Error()
+		}
+	}()
+	if err := csvWriter.Write(header); err != nil {
+		return err
+	}
+	for offset := 0; ; {
+		rows, items, totalItems, err := fetchPage(offset)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err := csvWriter.Write(row); err != nil {
+				return err
+			}
+		}
+		offset += items
+		if items == 0 || offset >= totalItems {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package mailchimp_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportSentToCSV(t *testing.T) {
+	emails := []string{"john@reese.com", "harold@finch.com", "sameen@shaw.com"}
+	var offsets []string
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/42694e9e57/sent-to", req.URL.Path)
+		assert.Equal(t, "1000", req.URL.Query().Get("count"))
+		offsets = append(offsets, req.URL.Query().Get("offset"))
+
+		// Return fewer items than requested to force several pages
+		offset := len(offsets) - 1
+		rw.WriteHeader(200)
+		fmt.Fprintf(
+			rw,
+			`{"sent_to": [{"email_address": "%s", "status": "sent", "open_count": %d}], "total_items": 3}`,
+			emails[offset],
+			offset,
+		)
+	})
+	defer server.Close()
+
+	var buf bytes.Buffer
+	assert.NoError(t, client.ExportSentToCSV("42694e9e57", &buf))
+	assert.Equal(t, []string{"", "1", "2"}, offsets)
+	assert.Equal(t, "email_address,status,open_count,last_open,absplit_group,gmt_offset\n"+
+		"john@reese.com,sent,0,,,0\n"+
+		"harold@finch.com,sent,1,,,0\n"+
+		"sameen@shaw.com,sent,2,,,0\n", buf.String())
+}
+
+func TestExportEmailActivityCSV(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/reports/42694e9e57/email-activity", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"emails": [%s], "total_items": 1}`, emailActivityResponse)
+	})
+	defer server.Close()
+
+	var buf bytes.Buffer
+	assert.NoError(t, client.ExportEmailActivityCSV("42694e9e57", &buf))
+	assert.Equal(t, "email_address,action,type,timestamp,url,ip\n"+
+		"john@reese.com,open,,2019-04-03T08:00:00+00:00,,203.0.113.7\n"+
+		"john@reese.com,click,,2019-04-03T08:01:00+00:00,https://example.com/pricing,203.0.113.7\n", buf.String())
+}
+
+func TestExportOpenDetailsCSV(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/reports/42694e9e57/open-details", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"members": [
+				{"email_address": "john@reese.com", "opens_count": 2, "opens": [
+					{"timestamp": "2019-04-03T08:00:00+00:00"},
+					{"timestamp": "2019-04-04T18:30:00+00:00"}
+				]}
+			],
+			"total_items": 1
+		}`)
+	})
+	defer server.Close()
+
+	var buf bytes.Buffer
+	assert.NoError(t, client.ExportOpenDetailsCSV("42694e9e57", &buf))
+	assert.Equal(t, "email_address,opens_count,timestamp\n"+
+		"john@reese.com,2,2019-04-03T08:00:00+00:00\n"+
+		"john@reese.com,2,2019-04-04T18:30:00+00:00\n", buf.String())
+}
+
+func TestExportCSVError(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(404)
+		fmt.Fprint(rw, notFoundErrorResponse)
+	})
+	defer server.Close()
+
+	var buf bytes.Buffer
+	err := client.ExportSentToCSV("42694e9e57", &buf)
+	assert.Equal(t, "Error 404 Resource Not Found (The requested resource could not be found.)", err.Error())
+}
+
+func TestExportCSV(t *testing.T) {
+	var offsets []int
+	var buf bytes.Buffer
+	err := mailchimp.ExportCSV(&buf, []string{"id", "name"}, func(offset int) ([][]string, int, int, error) {
+		offsets = append(offsets, offset)
+		return [][]string{{fmt.Sprint(offset), "list"}}, 2, 5, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 2, 4}, offsets)
+	assert.Equal(t, "id,name\n0,list\n2,list\n4,list\n", buf.String())
+}
+
+func TestExportCSVFlushesOnError(t *testing.T) {
+	fetchErr := errors.New("fetch failed")
+	var buf bytes.Buffer
+	err := mailchimp.ExportCSV(&buf, []string{"id"}, func(offset int) ([][]string, int, int, error) {
+		if offset > 0 {
+			return nil, 0, 0, fetchErr
+		}
+		return [][]string{{"first"}}, 1, 2, nil
+	})
+	assert.Equal(t, fetchErr, err)
+	// The rows of the pages fetched before the error are not lost
+	assert.Equal(t, "id\nfirst\n", buf.String())
+}
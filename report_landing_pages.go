@@ -0,0 +1,74 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// LandingPageEcommerce - the ecommerce stats of a landing page
+type LandingPageEcommerce struct {
+	TotalRevenue        float64 `json:"total_revenue"`
+	CurrencyCode        string  `json:"currency_code"`
+	TotalOrders         int     `json:"total_orders"`
+	AverageOrderRevenue float64 `json:"average_order_revenue"`
+}
+
+// LandingPageReport - see https://developer.mailchimp.com/documentation/mailchimp/reference/reports/landing-pages/
+type LandingPageReport struct {
+	ID             string               `json:"id"`
+	WebID          int                  `json:"web_id"` // The ID used in the Mailchimp web application.
+	Name           string               `json:"name"`
+	Title          string               `json:"title"`
+	URL            string               `json:"url"`
+	Status         string               `json:"status"` // One of LandingPagePublished, LandingPageUnpublished or LandingPageDraft.
+	ListID         string               `json:"list_id"`
+	ListName       string               `json:"list_name"`
+	PublishedAt    string               `json:"published_at"`
+	UnpublishedAt  string               `json:"unpublished_at"`
+	Visits         int                  `json:"visits"`          // The number of visits to the landing page.
+	UniqueVisits   int                  `json:"unique_visits"`   // The number of unique visitors to the landing page.
+	Subscribes     int                  `json:"subscribes"`      // The number of visitors who signed up to the list.
+	Clicks         int                  `json:"clicks"`          // The number of clicks on the landing page.
+	ConversionRate float64              `json:"conversion_rate"` // The percentage of unique visitors who signed up or made a purchase.
+	Ecommerce      LandingPageEcommerce `json:"ecommerce"`
+}
+
+// ListLandingPageReportsResponse ...
+type ListLandingPageReportsResponse struct {
+	LandingPages []LandingPageReport `json:"landing_pages"`
+	TotalItems   int                 `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// ListLandingPageReports returns a page of the reports of the landing pages in the account
+func (c *Client) ListLandingPageReports(params *PaginationParams) (*ListLandingPageReportsResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+	}
+	listLandingPageReportsResponse := new(ListLandingPageReportsResponse)
+	err := c.request(
+		"GET",
+		withQuery("/reports/landing-pages", query),
+		nil,
+		listLandingPageReportsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listLandingPageReportsResponse, nil
+}
+
+// GetLandingPageReport returns the report of a specific landing page
+func (c *Client) GetLandingPageReport(pageID string) (*LandingPageReport, error) {
+	landingPageReport := new(LandingPageReport)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/reports/landing-pages/%s", pageID),
+		nil,
+		landingPageReport,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return landingPageReport, nil
+}
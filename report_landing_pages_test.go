@@ -0,0 +1,46 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListLandingPageReports(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/landing-pages", req.URL.Path)
+		assert.Equal(t, "count=50", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"landing_pages": [%s], "total_items": 1}`, landingPageReportResponse)
+	})
+	defer server.Close()
+
+	listLandingPageReportsResponse, err := client.ListLandingPageReports(&mailchimp.PaginationParams{Count: 50})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, listLandingPageReportsResponse.TotalItems)
+	assert.Equal(t, 320, listLandingPageReportsResponse.LandingPages[0].Visits)
+}
+
+func TestGetLandingPageReport(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/landing-pages/lp_0f6b8366", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, landingPageReportResponse)
+	})
+	defer server.Close()
+
+	landingPageReport, err := client.GetLandingPageReport("lp_0f6b8366")
+	assert.NoError(t, err)
+	assert.Equal(t, 250, landingPageReport.UniqueVisits)
+	assert.Equal(t, 40, landingPageReport.Subscribes)
+	assert.Equal(t, 95, landingPageReport.Clicks)
+	assert.Equal(t, 16.0, landingPageReport.ConversionRate)
+	assert.Equal(t, "USD", landingPageReport.Ecommerce.CurrencyCode)
+}
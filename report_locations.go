@@ -0,0 +1,40 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// OpenLocation - the number of opens of a campaign from a region
+type OpenLocation struct {
+	CountryCode string `json:"country_code"` // The ISO 3166 2 digit country code.
+	Region      string `json:"region"`       // An abbreviation of the region, e.g. NY.
+	RegionName  string `json:"region_name"`
+	Opens       int    `json:"opens"`
+}
+
+// ReportLocationsResponse ...
+type ReportLocationsResponse struct {
+	Locations  []OpenLocation `json:"locations"`
+	CampaignID string         `json:"campaign_id"`
+	TotalItems int            `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// GetReportLocations returns a page of the regions a campaign was opened from
+func (c *Client) GetReportLocations(campaignID string, params *PaginationParams) (*ReportLocationsResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+	}
+	reportLocationsResponse := new(ReportLocationsResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/reports/%s/locations", campaignID), query),
+		nil,
+		reportLocationsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return reportLocationsResponse, nil
+}
@@ -0,0 +1,35 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetReportLocations(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/42694e9e57/locations", req.URL.Path)
+		assert.Equal(t, "count=20&offset=20", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"locations": [
+				{"country_code": "US", "region": "NY", "region_name": "New York", "opens": 120},
+				{"country_code": "DE", "region": "BE", "region_name": "Berlin", "opens": 31}
+			],
+			"campaign_id": "42694e9e57",
+			"total_items": 22
+		}`)
+	})
+	defer server.Close()
+
+	reportLocationsResponse, err := client.GetReportLocations("42694e9e57", &mailchimp.PaginationParams{Count: 20, Offset: 20})
+	assert.NoError(t, err)
+	assert.Equal(t, 22, reportLocationsResponse.TotalItems)
+	assert.Equal(t, "New York", reportLocationsResponse.Locations[0].RegionName)
+	assert.Equal(t, 31, reportLocationsResponse.Locations[1].Opens)
+}
@@ -0,0 +1,60 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// MemberOpen - a single open of a campaign
+type MemberOpen struct {
+	Timestamp string `json:"timestamp"`
+}
+
+// OpenDetailsMember - a list member who opened a campaign
+type OpenDetailsMember struct {
+	CampaignID    string                 `json:"campaign_id"`
+	ListID        string                 `json:"list_id"`
+	ListIsActive  bool                   `json:"list_is_active"`
+	ContactStatus string                 `json:"contact_status"`
+	EmailID       string                 `json:"email_id"` // The MD5 hash of the lowercase version of the member's email address.
+	EmailAddress  string                 `json:"email_address"`
+	MergeFields   map[string]interface{} `json:"merge_fields"`
+	VIP           bool                   `json:"vip"`
+	OpensCount    int                    `json:"opens_count"` // The number of times the member opened the campaign.
+	Opens         []MemberOpen           `json:"opens"`
+}
+
+// OpenDetailsParams ...
+type OpenDetailsParams struct {
+	PaginationParams
+	Since time.Time // Restrict results to opens after the set time.
+}
+
+// OpenDetailsResponse ...
+type OpenDetailsResponse struct {
+	Members    []OpenDetailsMember `json:"members"`
+	CampaignID string              `json:"campaign_id"`
+	TotalOpens int                 `json:"total_opens"` // The total number of opens matching the query.
+	TotalItems int                 `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// GetOpenDetails returns a page of the members who opened a campaign
+func (c *Client) GetOpenDetails(campaignID string, params *OpenDetailsParams) (*OpenDetailsResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+		setTime(query, "since", params.Since)
+	}
+	openDetailsResponse := new(OpenDetailsResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/reports/%s/open-details", campaignID), query),
+		nil,
+		openDetailsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return openDetailsResponse, nil
+}
@@ -0,0 +1,54 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOpenDetails(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/42694e9e57/open-details", req.URL.Path)
+		assert.Equal(t, "count=500&offset=500&since=2019-04-03T00%3A00%3A00%2B00%3A00", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"members": [
+				{
+					"campaign_id": "42694e9e57",
+					"list_id": "list_id",
+					"list_is_active": true,
+					"contact_status": "subscribed",
+					"email_id": "a12bef585f1cae41a46e7edd45ade769",
+					"email_address": "john@reese.com",
+					"merge_fields": {"FNAME": "John"},
+					"vip": false,
+					"opens_count": 2,
+					"opens": [
+						{"timestamp": "2019-04-03T08:00:00+00:00"},
+						{"timestamp": "2019-04-04T18:30:00+00:00"}
+					]
+				}
+			],
+			"campaign_id": "42694e9e57",
+			"total_opens": 2,
+			"total_items": 501
+		}`)
+	})
+	defer server.Close()
+
+	openDetailsResponse, err := client.GetOpenDetails("42694e9e57", &mailchimp.OpenDetailsParams{
+		PaginationParams: mailchimp.PaginationParams{Count: 500, Offset: 500},
+		Since:            time.Date(2019, 4, 3, 0, 0, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 501, openDetailsResponse.TotalItems)
+	member := openDetailsResponse.Members[0]
+	assert.Equal(t, 2, member.OpensCount)
+	assert.Equal(t, "2019-04-04T18:30:00+00:00", member.Opens[1].Timestamp)
+}
@@ -0,0 +1,48 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// SentTo - a member a campaign was sent to
+type SentTo struct {
+	EmailID      string                 `json:"email_id"` // The MD5 hash of the lowercase version of the member's email address.
+	EmailAddress string                 `json:"email_address"`
+	MergeFields  map[string]interface{} `json:"merge_fields"`
+	VIP          bool                   `json:"vip"`
+	Status       string                 `json:"status"`        // The delivery status: sent, hard (bounce) or soft (bounce).
+	OpenCount    int                    `json:"open_count"`    // The number of times the member opened the campaign.
+	LastOpen     string                 `json:"last_open"`     // The date and time the member last opened the campaign.
+	AbsplitGroup string                 `json:"absplit_group"` // For A/B split campaigns, the group the member was in.
+	GMTOffset    int                    `json:"gmt_offset"`    // For timewarp campaigns, the member's offset from GMT.
+	CampaignID   string                 `json:"campaign_id"`
+	ListID       string                 `json:"list_id"`
+	ListIsActive bool                   `json:"list_is_active"`
+}
+
+// SentToResponse ...
+type SentToResponse struct {
+	SentTo     []SentTo `json:"sent_to"`
+	CampaignID string   `json:"campaign_id"`
+	TotalItems int      `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// GetSentTo returns a page of the members a campaign was sent to
+func (c *Client) GetSentTo(campaignID string, params *PaginationParams) (*SentToResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+	}
+	sentToResponse := new(SentToResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/reports/%s/sent-to", campaignID), query),
+		nil,
+		sentToResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return sentToResponse, nil
+}
@@ -0,0 +1,28 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSentTo(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/42694e9e57/sent-to", req.URL.Path)
+		assert.Equal(t, "count=1000&offset=2000", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"sent_to": [%s], "campaign_id": "42694e9e57", "total_items": 2001}`, sentToResponse)
+	})
+	defer server.Close()
+
+	sentToResponse, err := client.GetSentTo("42694e9e57", &mailchimp.PaginationParams{Count: 1000, Offset: 2000})
+	assert.NoError(t, err)
+	assert.Equal(t, 2001, sentToResponse.TotalItems)
+	assert.Equal(t, "sent", sentToResponse.SentTo[0].Status)
+	assert.Equal(t, 2, sentToResponse.SentTo[0].OpenCount)
+}
@@ -0,0 +1,45 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// UnsubscribedMember - a member who unsubscribed from a list through a campaign
+type UnsubscribedMember struct {
+	EmailID      string                 `json:"email_id"` // The MD5 hash of the lowercase version of the member's email address.
+	EmailAddress string                 `json:"email_address"`
+	MergeFields  map[string]interface{} `json:"merge_fields"`
+	VIP          bool                   `json:"vip"`
+	Timestamp    string                 `json:"timestamp"` // The date and time the member unsubscribed.
+	Reason       string                 `json:"reason"`    // The reason given by the member, if any.
+	CampaignID   string                 `json:"campaign_id"`
+	ListID       string                 `json:"list_id"`
+	ListIsActive bool                   `json:"list_is_active"`
+}
+
+// UnsubscribedResponse ...
+type UnsubscribedResponse struct {
+	Unsubscribes []UnsubscribedMember `json:"unsubscribes"`
+	CampaignID   string               `json:"campaign_id"`
+	TotalItems   int                  `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// GetUnsubscribed returns a page of the members who unsubscribed through a campaign
+func (c *Client) GetUnsubscribed(campaignID string, params *PaginationParams) (*UnsubscribedResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+	}
+	unsubscribedResponse := new(UnsubscribedResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/reports/%s/unsubscribed", campaignID), query),
+		nil,
+		unsubscribedResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return unsubscribedResponse, nil
+}
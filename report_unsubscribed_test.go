@@ -0,0 +1,44 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUnsubscribed(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/42694e9e57/unsubscribed", req.URL.Path)
+		assert.Equal(t, "count=100", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"unsubscribes": [
+				{
+					"email_id": "a12bef585f1cae41a46e7edd45ade769",
+					"email_address": "john@reese.com",
+					"merge_fields": {"FNAME": "John"},
+					"vip": false,
+					"timestamp": "2019-04-03T09:00:00+00:00",
+					"reason": "I no longer want to receive these emails",
+					"campaign_id": "42694e9e57",
+					"list_id": "list_id",
+					"list_is_active": true
+				}
+			],
+			"campaign_id": "42694e9e57",
+			"total_items": 1
+		}`)
+	})
+	defer server.Close()
+
+	unsubscribedResponse, err := client.GetUnsubscribed("42694e9e57", &mailchimp.PaginationParams{Count: 100})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, unsubscribedResponse.TotalItems)
+	assert.Equal(t, "john@reese.com", unsubscribedResponse.Unsubscribes[0].EmailAddress)
+	assert.Equal(t, "I no longer want to receive these emails", unsubscribedResponse.Unsubscribes[0].Reason)
+}
@@ -0,0 +1,123 @@
+package mailchimp
+
+import (
+	"fmt"
+)
+
+// ReportBounces - the bounce summary of a campaign report
+type ReportBounces struct {
+	HardBounces  int `json:"hard_bounces"`  // Emails which bounced permanently.
+	SoftBounces  int `json:"soft_bounces"`  // Emails which bounced temporarily.
+	SyntaxErrors int `json:"syntax_errors"` // Emails with invalid addresses.
+}
+
+// ReportForwards - the forwarding summary of a campaign report
+type ReportForwards struct {
+	ForwardsCount int `json:"forwards_count"`
+	ForwardsOpens int `json:"forwards_opens"` // The number of opens of forwarded emails.
+}
+
+// ReportOpens - the open summary of a campaign report
+type ReportOpens struct {
+	OpensTotal  int     `json:"opens_total"`
+	UniqueOpens int     `json:"unique_opens"`
+	OpenRate    float64 `json:"open_rate"` // The number of unique opens divided by the emails delivered.
+	LastOpen    string  `json:"last_open"`
+}
+
+// ReportClicks - the click summary of a campaign report
+type ReportClicks struct {
+	ClicksTotal            int     `json:"clicks_total"`
+	UniqueClicks           int     `json:"unique_clicks"`            // The number of unique clicks on links.
+	UniqueSubscriberClicks int     `json:"unique_subscriber_clicks"` // The number of subscribers who clicked at least once.
+	ClickRate              float64 `json:"click_rate"`               // The number of unique subscriber clicks divided by the emails delivered.
+	LastClick              string  `json:"last_click"`
+}
+
+// ReportListStats - the average stats of the campaign's list
+type ReportListStats struct {
+	SubRate   float64 `json:"sub_rate"`   // The average number of subscriptions per month.
+	UnsubRate float64 `json:"unsub_rate"` // The average number of unsubscriptions per month.
+	OpenRate  float64 `json:"open_rate"`
+	ClickRate float64 `json:"click_rate"`
+}
+
+// ReportDeliveryStatus - the delivery progress of a campaign
+type ReportDeliveryStatus struct {
+	Enabled        bool   `json:"enabled"`    // Whether delivery status is available for the campaign.
+	CanCancel      bool   `json:"can_cancel"` // Whether the send can be canceled with CancelSend.
+	Status         string `json:"status"`     // e.g. delivering, delivered, canceling or canceled.
+	EmailsSent     int    `json:"emails_sent"`
+	EmailsCanceled int    `json:"emails_canceled"`
+}
+
+// ReportTimeseries - the engagement of a campaign during an hour after sending
+type ReportTimeseries struct {
+	Timestamp        string `json:"timestamp"`
+	EmailsSent       int    `json:"emails_sent"`
+	UniqueOpens      int    `json:"unique_opens"`
+	RecipientsClicks int    `json:"recipients_clicks"`
+}
+
+// Report - see https://developer.mailchimp.com/documentation/mailchimp/reference/reports/
+type Report struct {
+	ID             string               `json:"id"` // The ID of the campaign.
+	CampaignTitle  string               `json:"campaign_title"`
+	Type           CampaignType         `json:"type"`
+	ListID         string               `json:"list_id"`
+	ListIsActive   bool                 `json:"list_is_active"`
+	ListName       string               `json:"list_name"`
+	SubjectLine    string               `json:"subject_line"`
+	PreviewText    string               `json:"preview_text"`
+	EmailsSent     int                  `json:"emails_sent"`
+	AbuseReports   int                  `json:"abuse_reports"`
+	Unsubscribed   int                  `json:"unsubscribed"`
+	SendTime       string               `json:"send_time"`
+	RSSLastSend    string               `json:"rss_last_send"` // For RSS campaigns, the date and time of the last send.
+	Bounces        ReportBounces        `json:"bounces"`
+	Forwards       ReportForwards       `json:"forwards"`
+	Opens          ReportOpens          `json:"opens"`
+	Clicks         ReportClicks         `json:"clicks"`
+	ListStats      ReportListStats      `json:"list_stats"`
+	Timeseries     []ReportTimeseries   `json:"timeseries"` // Engagement for the first 24 hours after sending.
+	DeliveryStatus ReportDeliveryStatus `json:"delivery_status"`
+}
+
+// SubReportsResponse ...
+type SubReportsResponse struct {
+	Reports          []Report `json:"reports"`
+	ParentCampaignID string   `json:"parent_campaign_id"`
+	CampaignID       string   `json:"campaign_id"`
+	TotalItems       int      `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// GetReport returns the report of a sent campaign
+func (c *Client) GetReport(campaignID string) (*Report, error) {
+	report := new(Report)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/reports/%s", campaignID),
+		nil,
+		report,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// GetSubReports returns the reports of the child campaigns of a campaign,
+// e.g. the combinations of a variate campaign
+func (c *Client) GetSubReports(campaignID string) (*SubReportsResponse, error) {
+	subReportsResponse := new(SubReportsResponse)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/reports/%s/sub-reports", campaignID),
+		nil,
+		subReportsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return subReportsResponse, nil
+}
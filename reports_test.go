@@ -0,0 +1,53 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetReport(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/42694e9e57", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, reportResponse)
+	})
+	defer server.Close()
+
+	report, err := client.GetReport("42694e9e57")
+	assert.NoError(t, err)
+	assert.Equal(t, 1200, report.EmailsSent)
+	assert.Equal(t, 310, report.Opens.UniqueOpens)
+	assert.Equal(t, 0.0351, report.Clicks.ClickRate)
+	assert.Equal(t, 2, report.Bounces.HardBounces)
+	assert.Equal(t, 4, report.Unsubscribed)
+	assert.Equal(t, "delivered", report.DeliveryStatus.Status)
+	assert.Len(t, report.Timeseries, 1)
+}
+
+func TestGetSubReports(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reports/42694e9e57/sub-reports", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{
+			"reports": [%s, {"id": "b03bfc273a", "subject_line": "What we shipped in April", "opens": {"unique_opens": 280}}],
+			"parent_campaign_id": "42694e9e57",
+			"campaign_id": "42694e9e57",
+			"total_items": 2
+		}`, reportResponse)
+	})
+	defer server.Close()
+
+	subReportsResponse, err := client.GetSubReports("42694e9e57")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, subReportsResponse.TotalItems)
+	assert.Equal(t, "42694e9e57", subReportsResponse.ParentCampaignID)
+	assert.Equal(t, 310, subReportsResponse.Reports[0].Opens.UniqueOpens)
+	assert.Equal(t, 280, subReportsResponse.Reports[1].Opens.UniqueOpens)
+}
@@ -1,19 +1,54 @@
 package mailchimp
 
+import (
+	"github.com/RichardKnop/go-mailchimp/status"
+)
+
 // MemberResponse - see https://api.mailchimp.com/schema/3.0/Lists/Members/Instance.json?_ga=1.216961300.323879299.1464708316
 type MemberResponse struct {
 	ID              string                 `json:"id"` // The MD5 hash of the list member's email address.
 	EmailAddress    string                 `json:"email_address"`
 	UniqueEmailID   string                 `json:"unique_email_id"` // An identifier for the address across all of MailChimp.
 	EmailType       string                 `json:"email_type"`      // Type of email this member asked to get ('html' or 'text').
-	Status          string                 `json:"status"`
+	Status          status.MemberStatus    `json:"status"`
+	Stats           MemberStats            `json:"stats"` // Open and click rates for this subscriber.
 	VIP             bool                   `json:"vip"`
 	IPSignup        string                 `json:"ip_signup"`        // IP address the subscriber signed up from.
 	TimestampSignup string                 `json:"timestamp_signup"` // Date and time the subscriber signed up for the list.
 	IPOpt           string                 `json:"ip_opt"`           // IP address the subscriber confirmed their opt-in status.
 	TimestampOpt    string                 `json:"timestamp_opt"`    // Date and time the subscribe confirmed their opt-in status.
 	MemberRating    uint                   `json:"member_rating"`    // Star rating for this member between 1 and 5.
+	Language        string                 `json:"language"`         // If set/detected, the subscriber's language.
 	LastChanged     string                 `json:"last_changed"`     // Date and time the member's info was last changed.
 	ListID          string                 `json:"list_id"`          // The id for the list.
 	MergeFields     map[string]interface{} `json:"merge_fields"`     // merge fields
+	Interests       map[string]bool        `json:"interests"`        // The key of this object's properties is the ID of the interest in question.
+	TagsCount       int                    `json:"tags_count"`       // The number of tags applied to this member.
+	Tags            []MemberTag            `json:"tags"`             // The tags applied to this member.
+	Location        MemberLocation         `json:"location"`         // Subscriber location information.
+
+	MarketingPermissions []MarketingPermission `json:"marketing_permissions"` // The marketing permissions for the subscriber.
+}
+
+// MemberStats - open and click rates for a list member
+type MemberStats struct {
+	AvgOpenRate  float64 `json:"avg_open_rate"`  // A subscriber's average open rate.
+	AvgClickRate float64 `json:"avg_click_rate"` // A subscriber's average clickthrough rate.
+}
+
+// MemberLocation - subscriber location information. Only the latitude and longitude can be set.
+type MemberLocation struct {
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	GMTOff      int     `json:"gmtoff,omitempty"`       // The time difference in hours from GMT.
+	DSTOff      int     `json:"dstoff,omitempty"`       // The offset for timezones where daylight saving time is observed.
+	CountryCode string  `json:"country_code,omitempty"` // The unique code for the location country.
+	Timezone    string  `json:"timezone,omitempty"`     // The timezone for the location.
+}
+
+// MemberTag - a tag applied to a list member
+type MemberTag struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	DateAdded string `json:"date_added,omitempty"` // Only returned when listing a member's tags.
 }
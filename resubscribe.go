@@ -0,0 +1,26 @@
+package mailchimp
+
+import (
+	"errors"
+
+	"github.com/RichardKnop/go-mailchimp/status"
+)
+
+// Resubscribe subscribes the email, adding a new member or updating an existing
+// one. If the member is in compliance state (previously unsubscribed or cleaned),
+// it falls back to pending status so Mailchimp asks the member to confirm.
+func (c *Client) Resubscribe(listID string, email string, mergeFields map[string]interface{}) (*MemberResponse, error) {
+	memberResponse, err := c.AddOrUpdateMember(listID, email, &AddOrUpdateMemberParams{
+		StatusIfNew: status.Subscribed,
+		Status:      status.Subscribed,
+		MergeFields: mergeFields,
+	})
+	if err == nil || !errors.Is(err, ErrMemberInComplianceState) {
+		return memberResponse, err
+	}
+	return c.AddOrUpdateMember(listID, email, &AddOrUpdateMemberParams{
+		StatusIfNew: status.Pending,
+		Status:      status.Pending,
+		MergeFields: mergeFields,
+	})
+}
@@ -0,0 +1,63 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/RichardKnop/go-mailchimp/status"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResubscribe(t *testing.T) {
+	var statuses []interface{}
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PUT", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		statuses = append(statuses, params["status"])
+
+		if params["status"] == string(status.Subscribed) {
+			rw.WriteHeader(400)
+			fmt.Fprint(rw, complianceStateErrorResponse)
+			return
+		}
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, pendingResponse)
+	})
+	defer server.Close()
+
+	memberResponse, err := client.Resubscribe("list_id", "john@reese.com", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, status.Pending, memberResponse.Status)
+	assert.Equal(t, []interface{}{string(status.Subscribed), string(status.Pending)}, statuses)
+}
+
+func TestResubscribeError(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(400)
+		fmt.Fprint(rw, invalidMergeFieldsErrorResponse)
+	})
+	defer server.Close()
+
+	memberResponse, err := client.Resubscribe("list_id", "john@reese.com", nil)
+	assert.Nil(t, memberResponse)
+	assert.False(t, errors.Is(err, mailchimp.ErrMemberInComplianceState))
+	assert.Equal(t, "Error 400 Invalid Resource (Your merge fields were invalid.)", err.Error())
+}
+
+func TestComplianceStateError(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(400)
+		fmt.Fprint(rw, complianceStateErrorResponse)
+	})
+	defer server.Close()
+
+	_, err := client.Subscribe("list_id", "john@reese.com", nil)
+	assert.True(t, errors.Is(err, mailchimp.ErrMemberInComplianceState))
+}
@@ -0,0 +1,34 @@
+package mailchimp
+
+import (
+	"net/url"
+)
+
+// SearchCampaignsResult - a campaign matching the search query
+type SearchCampaignsResult struct {
+	Campaign Campaign `json:"campaign"`
+	Snippet  string   `json:"snippet"` // The part of the campaign content matching the query.
+}
+
+// SearchCampaignsResponse - see https://developer.mailchimp.com/documentation/mailchimp/reference/search-campaigns/
+type SearchCampaignsResponse struct {
+	Results    []SearchCampaignsResult `json:"results"`
+	TotalItems int                     `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// SearchCampaigns searches for campaigns by subject line, title or content
+func (c *Client) SearchCampaigns(query string) (*SearchCampaignsResponse, error) {
+	values := url.Values{}
+	values.Set("query", query)
+	searchCampaignsResponse := new(SearchCampaignsResponse)
+	err := c.request(
+		"GET",
+		withQuery("/search-campaigns", values),
+		nil,
+		searchCampaignsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return searchCampaignsResponse, nil
+}
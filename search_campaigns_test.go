@@ -0,0 +1,27 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchCampaigns(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/search-campaigns", req.URL.Path)
+		assert.Equal(t, "query=april+news", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"results": [{"campaign": %s, "snippet": "<b>April news</b>"}], "total_items": 1}`, campaignResponse)
+	})
+	defer server.Close()
+
+	searchCampaignsResponse, err := client.SearchCampaigns("april news")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, searchCampaignsResponse.TotalItems)
+	assert.Equal(t, "42694e9e57", searchCampaignsResponse.Results[0].Campaign.ID)
+	assert.Equal(t, "<b>April news</b>", searchCampaignsResponse.Results[0].Snippet)
+}
@@ -0,0 +1,38 @@
+package mailchimp
+
+import (
+	"net/url"
+)
+
+// SearchMembersResponse - see https://developer.mailchimp.com/documentation/mailchimp/reference/search-members/
+type SearchMembersResponse struct {
+	ExactMatches SearchMembersMatches `json:"exact_matches"`
+	FullSearch   SearchMembersMatches `json:"full_search"`
+}
+
+// SearchMembersMatches ...
+type SearchMembersMatches struct {
+	Members    []MemberResponse `json:"members"`
+	TotalItems int              `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// SearchMembers searches for list members by email address or name. Pass an
+// empty listID to search across all lists of the account.
+func (c *Client) SearchMembers(query string, listID string) (*SearchMembersResponse, error) {
+	values := url.Values{}
+	values.Set("query", query)
+	if listID != "" {
+		values.Set("list_id", listID)
+	}
+	searchMembersResponse := new(SearchMembersResponse)
+	err := c.request(
+		"GET",
+		withQuery("/search-members", values),
+		nil,
+		searchMembersResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return searchMembersResponse, nil
+}
@@ -0,0 +1,43 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchMembers(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/search-members", req.URL.Path)
+		assert.Equal(t, "list_id=list_id&query=reese", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"exact_matches": {"members": [], "total_items": 0},
+			"full_search": {"members": [{"email_address": "john@reese.com", "status": "subscribed"}], "total_items": 1}
+		}`)
+	})
+	defer server.Close()
+
+	searchMembersResponse, err := client.SearchMembers("reese", "list_id")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, searchMembersResponse.ExactMatches.TotalItems)
+	assert.Equal(t, 1, searchMembersResponse.FullSearch.TotalItems)
+	assert.Equal(t, "john@reese.com", searchMembersResponse.FullSearch.Members[0].EmailAddress)
+}
+
+func TestSearchMembersAllLists(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "query=john%40reese.com", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"exact_matches": {"members": [], "total_items": 0}, "full_search": {"members": [], "total_items": 0}}`)
+	})
+	defer server.Close()
+
+	_, err := client.SearchMembers("john@reese.com", "")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,112 @@
+package mailchimp
+
+import (
+	"fmt"
+)
+
+// SegmentMatch - whether members must match any or all of the segment conditions
+type SegmentMatch string
+
+// Segment matches
+const (
+	SegmentMatchAny SegmentMatch = "any"
+	SegmentMatchAll SegmentMatch = "all"
+)
+
+// ConditionType - the type of a segment condition
+type ConditionType string
+
+// Condition types
+const (
+	ConditionTextMerge     ConditionType = "TextMerge"
+	ConditionEmailAddress  ConditionType = "EmailAddress"
+	ConditionInterests     ConditionType = "Interests"
+	ConditionStaticSegment ConditionType = "StaticSegment"
+	ConditionMemberRating  ConditionType = "MemberRating"
+	ConditionDate          ConditionType = "Date"
+)
+
+// ConditionOp - the operator of a segment condition
+type ConditionOp string
+
+// Condition operators
+const (
+	OpIs                  ConditionOp = "is"
+	OpNot                 ConditionOp = "not"
+	OpContains            ConditionOp = "contains"
+	OpNotContain          ConditionOp = "notcontain"
+	OpStarts              ConditionOp = "starts"
+	OpEnds                ConditionOp = "ends"
+	OpGreater             ConditionOp = "greater"
+	OpLess                ConditionOp = "less"
+	OpBlank               ConditionOp = "blank"
+	OpBlankNot            ConditionOp = "blank_not"
+	OpInterestContains    ConditionOp = "interestcontains"
+	OpInterestContainsAll ConditionOp = "interestcontainsall"
+	OpInterestNotContains ConditionOp = "interestnotcontains"
+	OpStaticIs            ConditionOp = "static_is"
+	OpStaticNot           ConditionOp = "static_not"
+)
+
+// SegmentCondition - a single condition of a saved segment or campaign recipients
+type SegmentCondition struct {
+	ConditionType ConditionType `json:"condition_type"`
+	Field         string        `json:"field"`
+	Op            ConditionOp   `json:"op"`
+	Value         interface{}   `json:"value"`
+}
+
+// SegmentOptions - the conditions of a saved segment or campaign recipients.
+// Build them with NewSegmentOptions, e.g.
+//
+//	NewSegmentOptions(SegmentMatchAll).
+//		MergeField("FNAME", OpIs, "John").
+//		EmailAddress(OpEnds, "gmail.com")
+type SegmentOptions struct {
+	Match      SegmentMatch       `json:"match"`
+	Conditions []SegmentCondition `json:"conditions"`
+}
+
+// NewSegmentOptions returns segment options without any conditions
+func NewSegmentOptions(match SegmentMatch) *SegmentOptions {
+	return &SegmentOptions{
+		Match:      match,
+		Conditions: []SegmentCondition{},
+	}
+}
+
+// Condition adds a condition of any type
+func (o *SegmentOptions) Condition(conditionType ConditionType, field string, op ConditionOp, value interface{}) *SegmentOptions {
+	o.Conditions = append(o.Conditions, SegmentCondition{
+		ConditionType: conditionType,
+		Field:         field,
+		Op:            op,
+		Value:         value,
+	})
+	return o
+}
+
+// MergeField adds a condition on a text merge field, e.g. FNAME
+func (o *SegmentOptions) MergeField(tag string, op ConditionOp, value string) *SegmentOptions {
+	return o.Condition(ConditionTextMerge, tag, op, value)
+}
+
+// EmailAddress adds a condition on the email address
+func (o *SegmentOptions) EmailAddress(op ConditionOp, value string) *SegmentOptions {
+	return o.Condition(ConditionEmailAddress, "EMAIL", op, value)
+}
+
+// Interests adds a condition on the interests of an interest category
+func (o *SegmentOptions) Interests(categoryID string, op ConditionOp, interestIDs []string) *SegmentOptions {
+	return o.Condition(ConditionInterests, fmt.Sprintf("interests-%s", categoryID), op, interestIDs)
+}
+
+// StaticSegment adds a condition on membership of a static segment or tag
+func (o *SegmentOptions) StaticSegment(op ConditionOp, segmentID int) *SegmentOptions {
+	return o.Condition(ConditionStaticSegment, "static_segment", op, segmentID)
+}
+
+// MemberRating adds a condition on the member rating (1 to 5 stars)
+func (o *SegmentOptions) MemberRating(op ConditionOp, rating int) *SegmentOptions {
+	return o.Condition(ConditionMemberRating, "rating", op, rating)
+}
@@ -0,0 +1,31 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegmentOptionsBuilder(t *testing.T) {
+	options := mailchimp.NewSegmentOptions(mailchimp.SegmentMatchAll).
+		MergeField("FNAME", mailchimp.OpIs, "John").
+		EmailAddress(mailchimp.OpEnds, "gmail.com").
+		Interests("a1e9f4b7f6", mailchimp.OpInterestContains, []string{"9143cf3bd1"}).
+		StaticSegment(mailchimp.OpStaticIs, 23).
+		MemberRating(mailchimp.OpGreater, 3)
+
+	data, err := json.Marshal(options)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"match": "all",
+		"conditions": [
+			{"condition_type": "TextMerge", "field": "FNAME", "op": "is", "value": "John"},
+			{"condition_type": "EmailAddress", "field": "EMAIL", "op": "ends", "value": "gmail.com"},
+			{"condition_type": "Interests", "field": "interests-a1e9f4b7f6", "op": "interestcontains", "value": ["9143cf3bd1"]},
+			{"condition_type": "StaticSegment", "field": "static_segment", "op": "static_is", "value": 23},
+			{"condition_type": "MemberRating", "field": "rating", "op": "greater", "value": 3}
+		]
+	}`, string(data))
+}
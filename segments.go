@@ -0,0 +1,240 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// SegmentType - the type of a segment
+type SegmentType string
+
+// Segment types
+const (
+	// SegmentSaved - members are matched by the segment conditions
+	SegmentSaved SegmentType = "saved"
+	// SegmentStatic - members are added and removed explicitly. Tags are static segments.
+	SegmentStatic SegmentType = "static"
+	// SegmentFuzzy - created by Mailchimp, e.g. from reports
+	SegmentFuzzy SegmentType = "fuzzy"
+)
+
+// Segment - see https://developer.mailchimp.com/documentation/mailchimp/reference/lists/segments/
+type Segment struct {
+	ID          int             `json:"id"`
+	Name        string          `json:"name"`
+	MemberCount int             `json:"member_count"` // The number of active subscribers currently included in the segment.
+	Type        SegmentType     `json:"type"`
+	CreatedAt   string          `json:"created_at"` // The date and time the segment was created.
+	UpdatedAt   string          `json:"updated_at"` // The date and time the segment was last updated.
+	Options     *SegmentOptions `json:"options,omitempty"`
+	ListID      string          `json:"list_id"`
+}
+
+// SegmentParams - used both to create and to update a segment. Set
+// StaticSegment to create a static segment, or Options to create a saved one.
+type SegmentParams struct {
+	Name          string          `json:"name"`
+	StaticSegment []string        `json:"static_segment,omitempty"` // Emails to include in a static segment.
+	Options       *SegmentOptions `json:"options,omitempty"`
+}
+
+// ListSegmentsParams ...
+type ListSegmentsParams struct {
+	PaginationParams
+	Type SegmentType // Restrict results to segments of this type.
+}
+
+// ListSegmentsResponse ...
+type ListSegmentsResponse struct {
+	Segments   []Segment `json:"segments"`
+	ListID     string    `json:"list_id"`
+	TotalItems int       `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// SegmentBatchError - emails which could not be added to or removed from a segment
+type SegmentBatchError struct {
+	EmailAddresses []string `json:"email_addresses"`
+	Error          string   `json:"error"`
+}
+
+// SegmentBatchResponse ...
+type SegmentBatchResponse struct {
+	MembersAdded   []MemberResponse    `json:"members_added"`
+	MembersRemoved []MemberResponse    `json:"members_removed"`
+	Errors         []SegmentBatchError `json:"errors"`
+	TotalAdded     int                 `json:"total_added"`
+	TotalRemoved   int                 `json:"total_removed"`
+	ErrorCount     int                 `json:"error_count"`
+}
+
+func (r *SegmentBatchResponse) add(other *SegmentBatchResponse) {
+	r.MembersAdded = append(r.MembersAdded, other.MembersAdded...)
+	r.MembersRemoved = append(r.MembersRemoved, other.MembersRemoved...)
+	r.Errors = append(r.Errors, other.Errors...)
+	r.TotalAdded += other.TotalAdded
+	r.TotalRemoved += other.TotalRemoved
+	r.ErrorCount += other.ErrorCount
+}
+
+// ListSegments returns a page of the segments of a list
+func (c *Client) ListSegments(listID string, params *ListSegmentsParams) (*ListSegmentsResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+		if params.Type != "" {
+			query.Set("type", string(params.Type))
+		}
+	}
+	listSegmentsResponse := new(ListSegmentsResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/lists/%s/segments", listID), query),
+		nil,
+		listSegmentsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listSegmentsResponse, nil
+}
+
+// GetSegment returns a specific segment of a list
+func (c *Client) GetSegment(listID string, segmentID int) (*Segment, error) {
+	segment := new(Segment)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/segments/%d", listID, segmentID),
+		nil,
+		segment,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return segment, nil
+}
+
+// CreateSegment adds a new segment to a list
+func (c *Client) CreateSegment(listID string, params *SegmentParams) (*Segment, error) {
+	segment := new(Segment)
+	err := c.request(
+		"POST",
+		fmt.Sprintf("/lists/%s/segments", listID),
+		params,
+		segment,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return segment, nil
+}
+
+// UpdateSegment updates a specific segment of a list
+func (c *Client) UpdateSegment(listID string, segmentID int, params *SegmentParams) (*Segment, error) {
+	segment := new(Segment)
+	err := c.request(
+		"PATCH",
+		fmt.Sprintf("/lists/%s/segments/%d", listID, segmentID),
+		params,
+		segment,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return segment, nil
+}
+
+// DeleteSegment deletes a specific segment of a list
+func (c *Client) DeleteSegment(listID string, segmentID int) error {
+	return c.request(
+		"DELETE",
+		fmt.Sprintf("/lists/%s/segments/%d", listID, segmentID),
+		nil,
+		nil,
+	)
+}
+
+// ListSegmentMembers returns a page of members of a segment
+func (c *Client) ListSegmentMembers(listID string, segmentID int, params *PaginationParams) (*ListMembersResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+	}
+	listMembersResponse := new(ListMembersResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/lists/%s/segments/%d/members", listID, segmentID), query),
+		nil,
+		listMembersResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listMembersResponse, nil
+}
+
+// AddSegmentMember adds a list member to a static segment
+func (c *Client) AddSegmentMember(listID string, segmentID int, email string) (*MemberResponse, error) {
+	params := map[string]interface{}{
+		"email_address": email,
+	}
+	memberResponse := new(MemberResponse)
+	err := c.request(
+		"POST",
+		fmt.Sprintf("/lists/%s/segments/%d/members", listID, segmentID),
+		&params,
+		memberResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return memberResponse, nil
+}
+
+// RemoveSegmentMember removes a list member from a static segment
+func (c *Client) RemoveSegmentMember(listID string, segmentID int, email string) error {
+	return c.request(
+		"DELETE",
+		fmt.Sprintf("/lists/%s/segments/%d/members/%s", listID, segmentID, SubscriberHash(email)),
+		nil,
+		nil,
+	)
+}
+
+// createStaticSegment creates an empty static segment, which is how Mailchimp
+// stores tags. Unlike CreateSegment it sends an empty static_segment array.
+func (c *Client) createStaticSegment(listID string, name string) (*Segment, error) {
+	params := map[string]interface{}{
+		"name":           name,
+		"static_segment": []string{},
+	}
+	segment := new(Segment)
+	err := c.request(
+		"POST",
+		fmt.Sprintf("/lists/%s/segments", listID),
+		&params,
+		segment,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return segment, nil
+}
+
+// BatchSegmentMembers adds and removes up to MaxBatchMembers emails each of a static segment
+func (c *Client) BatchSegmentMembers(listID string, segmentID int, membersToAdd []string, membersToRemove []string) (*SegmentBatchResponse, error) {
+	params := map[string]interface{}{
+		"members_to_add":    membersToAdd,
+		"members_to_remove": membersToRemove,
+	}
+	segmentBatchResponse := new(SegmentBatchResponse)
+	err := c.request(
+		"POST",
+		fmt.Sprintf("/lists/%s/segments/%d", listID, segmentID),
+		&params,
+		segmentBatchResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return segmentBatchResponse, nil
+}
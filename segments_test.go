@@ -0,0 +1,138 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListSegments(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/segments", req.URL.Path)
+		assert.Equal(t, "type=saved", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"segments": [{"id": 31, "name": "Gmail users", "member_count": 12, "type": "saved"}], "list_id": "list_id", "total_items": 1}`)
+	})
+	defer server.Close()
+
+	listSegmentsResponse, err := client.ListSegments("list_id", &mailchimp.ListSegmentsParams{Type: mailchimp.SegmentSaved})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, listSegmentsResponse.TotalItems)
+	assert.Equal(t, mailchimp.SegmentSaved, listSegmentsResponse.Segments[0].Type)
+	assert.Equal(t, 12, listSegmentsResponse.Segments[0].MemberCount)
+}
+
+func TestCreateSegment(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists/list_id/segments", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"name": "Gmail users",
+			"options": map[string]interface{}{
+				"match": "all",
+				"conditions": []interface{}{
+					map[string]interface{}{"condition_type": "EmailAddress", "field": "EMAIL", "op": "ends", "value": "gmail.com"},
+				},
+			},
+		}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"id": 31, "name": "Gmail users", "type": "saved"}`)
+	})
+	defer server.Close()
+
+	segment, err := client.CreateSegment("list_id", &mailchimp.SegmentParams{
+		Name: "Gmail users",
+		Options: &mailchimp.SegmentOptions{
+			Match: "all",
+			Conditions: []mailchimp.SegmentCondition{
+				{ConditionType: "EmailAddress", Field: "EMAIL", Op: "ends", Value: "gmail.com"},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 31, segment.ID)
+}
+
+func TestDeleteSegment(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/lists/list_id/segments/31", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.DeleteSegment("list_id", 31))
+}
+
+func TestAddSegmentMember(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists/list_id/segments/23/members", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{"email_address": "john@reese.com"}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, successResponse)
+	})
+	defer server.Close()
+
+	memberResponse, err := client.AddSegmentMember("list_id", 23, "john@reese.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "john@reese.com", memberResponse.EmailAddress)
+}
+
+func TestRemoveSegmentMember(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/lists/list_id/segments/23/members/a12bef585f1cae41a46e7edd45ade769", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.RemoveSegmentMember("list_id", 23, "john@reese.com"))
+}
+
+func TestBatchSegmentMembers(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists/list_id/segments/23", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"members_to_add":    []interface{}{"john@reese.com"},
+			"members_to_remove": []interface{}{"harold@finch.com"},
+		}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{
+			"members_added": [{"email_address": "john@reese.com"}],
+			"members_removed": [{"email_address": "harold@finch.com"}],
+			"errors": [],
+			"total_added": 1,
+			"total_removed": 1,
+			"error_count": 0
+		}`)
+	})
+	defer server.Close()
+
+	segmentBatchResponse, err := client.BatchSegmentMembers("list_id", 23, []string{"john@reese.com"}, []string{"harold@finch.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, segmentBatchResponse.TotalAdded)
+	assert.Equal(t, 1, segmentBatchResponse.TotalRemoved)
+	assert.Equal(t, "harold@finch.com", segmentBatchResponse.MembersRemoved[0].EmailAddress)
+}
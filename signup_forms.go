@@ -0,0 +1,102 @@
+package mailchimp
+
+import (
+	"fmt"
+)
+
+// Signup form content sections
+const (
+	SignupFormSignupMessage       = "signup_message"
+	SignupFormUnsubMessage        = "unsub_message"
+	SignupFormSignupThankYouTitle = "signup_thank_you_title"
+)
+
+// SignupFormHeader - the header of a signup form
+type SignupFormHeader struct {
+	ImageURL         string `json:"image_url,omitempty"`
+	Text             string `json:"text,omitempty"` // Header text, used when no image is set.
+	ImageWidth       string `json:"image_width,omitempty"`
+	ImageHeight      string `json:"image_height,omitempty"`
+	ImageAlt         string `json:"image_alt,omitempty"`
+	ImageLink        string `json:"image_link,omitempty"` // The URL the header image links to.
+	ImageAlign       string `json:"image_align,omitempty"`
+	ImageBorderWidth string `json:"image_border_width,omitempty"`
+	ImageBorderStyle string `json:"image_border_style,omitempty"`
+	ImageBorderColor string `json:"image_border_color,omitempty"`
+	ImageTarget      string `json:"image_target,omitempty"` // The target of the header image link, e.g. _blank.
+}
+
+// SignupFormContent - the text of a signup form section
+type SignupFormContent struct {
+	Section string `json:"section"` // One of the SignupForm* section constants.
+	Value   string `json:"value"`
+}
+
+// SignupFormStyleOption - a single CSS property
+type SignupFormStyleOption struct {
+	Property string `json:"property"`
+	Value    string `json:"value"`
+}
+
+// SignupFormStyle - the CSS properties applied to an element of a signup form
+type SignupFormStyle struct {
+	Selector string                  `json:"selector"` // e.g. page_background, header_text, form_button.
+	Options  []SignupFormStyleOption `json:"options"`
+}
+
+// SignupForm - see https://developer.mailchimp.com/documentation/mailchimp/reference/lists/signup-forms/
+type SignupForm struct {
+	Header        SignupFormHeader    `json:"header"`
+	Contents      []SignupFormContent `json:"contents"`
+	Styles        []SignupFormStyle   `json:"styles"`
+	SignupFormURL string              `json:"signup_form_url"` // The hosted signup form's URL.
+	ListID        string              `json:"list_id"`
+}
+
+// SignupFormParams - used to customize a list's signup form
+// The signup forms endpoint has no success (redirect) URL field. The URL
+// subscribers are sent to after signing up is set in the Mailchimp web
+// application, on the form builder's signup "thank you" page; the thank you
+// page's text can be set here with the SignupFormSignupThankYouTitle section.
+type SignupFormParams struct {
+	Header   *SignupFormHeader   `json:"header,omitempty"`
+	Contents []SignupFormContent `json:"contents,omitempty"`
+	Styles   []SignupFormStyle   `json:"styles,omitempty"`
+}
+
+// ListSignupFormsResponse ...
+type ListSignupFormsResponse struct {
+	SignupForms []SignupForm `json:"signup_forms"`
+	ListID      string       `json:"list_id"`
+	TotalItems  int          `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// ListSignupForms returns the signup forms of a list
+func (c *Client) ListSignupForms(listID string) (*ListSignupFormsResponse, error) {
+	listSignupFormsResponse := new(ListSignupFormsResponse)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/signup-forms", listID),
+		nil,
+		listSignupFormsResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listSignupFormsResponse, nil
+}
+
+// CustomizeSignupForm customizes the hosted signup form of a list
+func (c *Client) CustomizeSignupForm(listID string, params *SignupFormParams) (*SignupForm, error) {
+	signupForm := new(SignupForm)
+	err := c.request(
+		"POST",
+		fmt.Sprintf("/lists/%s/signup-forms", listID),
+		params,
+		signupForm,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return signupForm, nil
+}
@@ -0,0 +1,58 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListSignupForms(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/signup-forms", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"signup_forms": [%s], "list_id": "list_id", "total_items": 1}`, signupFormResponse)
+	})
+	defer server.Close()
+
+	listSignupFormsResponse, err := client.ListSignupForms("list_id")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, listSignupFormsResponse.TotalItems)
+	signupForm := listSignupFormsResponse.SignupForms[0]
+	assert.Equal(t, "Join Acme", signupForm.Header.Text)
+	assert.Equal(t, mailchimp.SignupFormSignupMessage, signupForm.Contents[0].Section)
+	assert.Equal(t, "#ffffff", signupForm.Styles[0].Options[0].Value)
+	assert.Equal(t, "http://eepurl.com/abc123", signupForm.SignupFormURL)
+}
+
+func TestCustomizeSignupForm(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists/list_id/signup-forms", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, "Join Acme", params["header"].(map[string]interface{})["text"])
+		assert.Len(t, params["contents"], 1)
+		_, ok := params["styles"]
+		assert.False(t, ok)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, signupFormResponse)
+	})
+	defer server.Close()
+
+	signupForm, err := client.CustomizeSignupForm("list_id", &mailchimp.SignupFormParams{
+		Header: &mailchimp.SignupFormHeader{Text: "Join Acme"},
+		Contents: []mailchimp.SignupFormContent{
+			{Section: mailchimp.SignupFormSignupMessage, Value: "Get the latest news from Acme."},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "list_id", signupForm.ListID)
+}
@@ -1,12 +1,19 @@
 package status
 
+// MemberStatus - subscription status of a list member
+type MemberStatus string
+
 const (
 	// Subscribed - This address is on the list and ready to receive email. You can only send campaigns to ‘subscribed’ addresses.
-	Subscribed = "subscribed"
-	// Unsubscribed - This address is on the list and ready to receive email. You can only send campaigns to ‘subscribed’ addresses.
-	Unsubscribed = "unsubscribed"
-	// Pending - This address used to be on the list but isn’t anymore.
-	Pending = "pending"
-	// Cleaned - This address requested to be added with double-opt-in but hasn’t confirmed their subscription yet.
-	Cleaned = "cleaned"
+	Subscribed MemberStatus = "subscribed"
+	// Unsubscribed - This address used to be on the list but isn’t anymore.
+	Unsubscribed MemberStatus = "unsubscribed"
+	// Pending - This address requested to be added with double-opt-in but hasn’t confirmed their subscription yet.
+	Pending MemberStatus = "pending"
+	// Cleaned - This address bounced and has been removed from the list.
+	Cleaned MemberStatus = "cleaned"
+	// Transactional - This address was added via an ecommerce store or API and can only receive transactional email.
+	Transactional MemberStatus = "transactional"
+	// Archived - This address was archived and can be added back to the list later.
+	Archived MemberStatus = "archived"
 )
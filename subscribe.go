@@ -1,51 +1,71 @@
 package mailchimp
 
 import (
-	"encoding/json"
 	"fmt"
-	"io/ioutil"
 
 	"github.com/RichardKnop/go-mailchimp/status"
 )
 
+// SubscribeParams - see https://developer.mailchimp.com/documentation/mailchimp/reference/lists/members/#create-post_lists_list_id_members
+type SubscribeParams struct {
+	EmailType   string                 `json:"email_type,omitempty"` // Type of email this member asked to get ('html' or 'text').
+	Status      status.MemberStatus    `json:"status"`               // Defaults to subscribed.
+	MergeFields map[string]interface{} `json:"merge_fields,omitempty"`
+	Interests   map[string]bool        `json:"interests,omitempty"` // The key of this object's properties is the ID of the interest in question.
+	Language    string                 `json:"language,omitempty"`  // If set/detected, the subscriber's language.
+	VIP         bool                   `json:"vip,omitempty"`
+	Tags        []string               `json:"tags,omitempty"` // The tags that are associated with a member.
+	Location    *MemberLocation        `json:"location,omitempty"`
+
+	MarketingPermissions []MarketingPermission `json:"marketing_permissions,omitempty"`
+
+	IPSignup        string `json:"ip_signup,omitempty"`        // IP address the subscriber signed up from.
+	TimestampSignup string `json:"timestamp_signup,omitempty"` // Date and time the subscriber signed up for the list in ISO 8601 format.
+	IPOpt           string `json:"ip_opt,omitempty"`           // IP address the subscriber confirmed their opt-in status.
+	TimestampOpt    string `json:"timestamp_opt,omitempty"`    // Date and time the subscriber confirmed their opt-in status in ISO 8601 format.
+}
+
+type subscribeRequest struct {
+	EmailAddress string `json:"email_address"`
+	SubscribeParams
+}
+
 // Subscribe ...
 func (c *Client) Subscribe(listID string, email string, mergeFields map[string]interface{}) (*MemberResponse, error) {
-	// Make request
-	params := map[string]interface{}{
-		"email_address": email,
-		"status":        status.Subscribed,
-		"merge_fields":  mergeFields,
-	}
-	resp, err := c.do(
-		"POST",
-		fmt.Sprintf("/lists/%s/members/", listID),
-		&params,
-	)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return c.SubscribeWithOptions(listID, email, &SubscribeParams{MergeFields: mergeFields})
+}
 
-	// Read the response body
-	data, err := ioutil.ReadAll(resp.Body)
+// SubscribeWithOptions adds a new member to the list
+func (c *Client) SubscribeWithOptions(listID string, email string, params *SubscribeParams) (*MemberResponse, error) {
+	email, err := c.validateEmail(email)
 	if err != nil {
 		return nil, err
 	}
-
-	// Allow any success status (2xx)
-	if resp.StatusCode/100 == 2 {
-		// Unmarshal response into MemberResponse struct
-		memberResponse := new(MemberResponse)
-		if err := json.Unmarshal(data, memberResponse); err != nil {
-			return nil, err
-		}
-		return memberResponse, nil
+	body := &subscribeRequest{EmailAddress: email}
+	if params != nil {
+		body.SubscribeParams = *params
 	}
-
-	// Request failed
-	errorResponse, err := extractError(data)
+	if body.Status == "" {
+		body.Status = status.Subscribed
+	}
+	memberResponse := new(MemberResponse)
+	err = c.request(
+		"POST",
+		fmt.Sprintf("/lists/%s/members/", listID),
+		body,
+		memberResponse,
+	)
 	if err != nil {
 		return nil, err
 	}
-	return nil, errorResponse
+	return memberResponse, nil
+}
+
+// SubscribePending adds a new member to the list with pending status, so
+// Mailchimp sends a confirmation email (double opt-in) before subscribing them
+func (c *Client) SubscribePending(listID string, email string, mergeFields map[string]interface{}) (*MemberResponse, error) {
+	return c.SubscribeWithOptions(listID, email, &SubscribeParams{
+		Status:      status.Pending,
+		MergeFields: mergeFields,
+	})
 }
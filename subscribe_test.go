@@ -1,6 +1,8 @@
 package mailchimp_test
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -41,6 +43,9 @@ func TestSubscribeError(t *testing.T) {
 	assert.Equal(t, "Member Exists", errResponse.Title)
 	assert.Equal(t, 400, errResponse.Status)
 	assert.Equal(t, " is already a list member. Use PUT to insert or update list members.", errResponse.Detail)
+
+	assert.True(t, errors.Is(err, mailchimp.ErrMemberExists))
+	assert.False(t, errors.Is(err, mailchimp.ErrMemberInComplianceState))
 }
 
 func TestSubscribeMalformedError(t *testing.T) {
@@ -93,3 +98,89 @@ func TestSubscribe(t *testing.T) {
 	assert.Equal(t, "john@reese.com", memberResponse.EmailAddress)
 	assert.Equal(t, status.Subscribed, memberResponse.Status)
 }
+
+func TestSubscribeWithOptions(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists/list_id/members/", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"email_address": "john@reese.com",
+			"status":        string(status.Subscribed),
+			"merge_fields":  map[string]interface{}{"FNAME": "John", "LNAME": "Reese"},
+			"tags":          []interface{}{"customer"},
+			"language":      "en",
+		}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, successResponse)
+	})
+	defer server.Close()
+
+	memberResponse, err := client.SubscribeWithOptions("list_id", "john@reese.com", &mailchimp.SubscribeParams{
+		MergeFields: map[string]interface{}{"FNAME": "John", "LNAME": "Reese"},
+		Tags:        []string{"customer"},
+		Language:    "en",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "11bf13d1eb58116eba1de370b2bd796b", memberResponse.ID)
+	assert.Equal(t, status.Subscribed, memberResponse.Status)
+}
+
+func TestSubscribePending(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, string(status.Pending), params["status"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, pendingResponse)
+	})
+	defer server.Close()
+
+	memberResponse, err := client.SubscribePending("list_id", "john@reese.com", map[string]interface{}{})
+	assert.NoError(t, err)
+	assert.Equal(t, status.Pending, memberResponse.Status)
+}
+
+func TestSubscribeWithInterests(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{"9143cf3bd1": true}, params["interests"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, successResponse)
+	})
+	defer server.Close()
+
+	_, err := client.SubscribeWithOptions("list_id", "john@reese.com", &mailchimp.SubscribeParams{
+		Interests: map[string]bool{"9143cf3bd1": true},
+	})
+	assert.NoError(t, err)
+}
+
+func TestSubscribeWithSignupMetadata(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, "101.8.90.86", params["ip_signup"])
+		assert.Equal(t, "2016-06-03T07:13:07+00:00", params["timestamp_signup"])
+		assert.Equal(t, "101.8.90.86", params["ip_opt"])
+		assert.Equal(t, "2016-06-03T07:15:00+00:00", params["timestamp_opt"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, successResponse)
+	})
+	defer server.Close()
+
+	_, err := client.SubscribeWithOptions("list_id", "john@reese.com", &mailchimp.SubscribeParams{
+		IPSignup:        "101.8.90.86",
+		TimestampSignup: "2016-06-03T07:13:07+00:00",
+		IPOpt:           "101.8.90.86",
+		TimestampOpt:    "2016-06-03T07:15:00+00:00",
+	})
+	assert.NoError(t, err)
+}
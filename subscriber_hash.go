@@ -0,0 +1,13 @@
+package mailchimp
+
+import (
+	"crypto/md5"
+	"fmt"
+	"strings"
+)
+
+// SubscriberHash returns the MD5 hash of the lowercased email address,
+// which Mailchimp uses to identify list members in member endpoint URLs
+func SubscriberHash(email string) string {
+	return fmt.Sprintf("%x", md5.Sum([]byte(strings.ToLower(email))))
+}
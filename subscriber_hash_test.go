@@ -0,0 +1,13 @@
+package mailchimp_test
+
+import (
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriberHash(t *testing.T) {
+	assert.Equal(t, "a12bef585f1cae41a46e7edd45ade769", mailchimp.SubscriberHash("john@reese.com"))
+	assert.Equal(t, "a12bef585f1cae41a46e7edd45ade769", mailchimp.SubscriberHash("John@Reese.COM"))
+}
@@ -1,5 +1,28 @@
 package mailchimp_test
 
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClient returns a client pointed at a test server serving handler
+func newTestClient(t *testing.T, handler http.HandlerFunc) (mailchimp.ClientInterface, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	client, err := mailchimp.NewClient("the_api_key-us13", nil)
+	assert.NoError(t, err)
+
+	baseURL, _ := url.Parse(server.URL)
+	client.SetBaseURL(baseURL)
+
+	return client, server
+}
+
 var notFoundErrorResponse = `{
     "type": "http://developer.mailchimp.com/documentation/mailchimp/guides/error-glossary/",
     "title": "Resource Not Found",
@@ -16,6 +39,14 @@ var alreadySubscribedErrorResponse = `{
     "instance": ""
 }`
 
+var complianceStateErrorResponse = `{
+    "type": "http://developer.mailchimp.com/documentation/mailchimp/guides/error-glossary/",
+    "title": "Member In Compliance State",
+    "status": 400,
+    "detail": "john@reese.com is in a compliance state due to unsubscribe, bounce, or compliance review and cannot be subscribed.",
+    "instance": ""
+}`
+
 var invalidMergeFieldsErrorResponse = `{
     "type": "http://developer.mailchimp.com/documentation/mailchimp/guides/error-glossary/",
     "title": "Invalid Resource",
@@ -110,3 +141,646 @@ var successResponse = `{
         }
     ]
 }`
+
+var memberWithStatsResponse = `{
+    "id": "11bf13d1eb58116eba1de370b2bd796b",
+    "email_address": "john@reese.com",
+    "unique_email_id": "1b757e82a3",
+    "email_type": "html",
+    "status": "subscribed",
+    "merge_fields": {
+        "FNAME": "",
+        "LNAME": "",
+        "MMERGE4": "",
+        "MMERGE5": "",
+        "MMERGE3": ""
+    },
+    "stats": {
+        "avg_open_rate": 0.5,
+        "avg_click_rate": 0.25
+    },
+    "ip_signup": "",
+    "timestamp_signup": "",
+    "ip_opt": "101.8.90.86",
+    "timestamp_opt": "2016-06-03T07:13:07+00:00",
+    "member_rating": 2,
+    "last_changed": "2016-06-03T07:13:07+00:00",
+    "language": "",
+    "vip": false,
+    "email_client": "",
+    "location": {
+        "latitude": 40.7128,
+        "longitude": -74.006,
+        "gmtoff": -5,
+        "dstoff": -4,
+        "country_code": "US",
+        "timezone": "America/New_York"
+    },
+    "list_id": "0f6b836652",
+    "tags_count": 1,
+    "tags": [
+        {
+            "id": 17,
+            "name": "customer"
+        }
+    ],
+    "_links": [
+        {
+            "rel": "self",
+            "href": "https://us13.api.mailchimp.com/3.0/lists/0f6b836652/members/11bf13d1eb58116eba1de370b2bd796b",
+            "method": "GET",
+            "targetSchema": "https://us13.api.mailchimp.com/schema/3.0/Lists/Members/Instance.json"
+        },
+        {
+            "rel": "parent",
+            "href": "https://us13.api.mailchimp.com/3.0/lists/0f6b836652/members",
+            "method": "GET",
+            "targetSchema": "https://us13.api.mailchimp.com/schema/3.0/Lists/Members/Collection.json",
+            "schema": "https://us13.api.mailchimp.com/schema/3.0/CollectionLinks/Lists/Members.json"
+        },
+        {
+            "rel": "update",
+            "href": "https://us13.api.mailchimp.com/3.0/lists/0f6b836652/members/11bf13d1eb58116eba1de370b2bd796b",
+            "method": "PATCH",
+            "schema": "https://us13.api.mailchimp.com/schema/3.0/Lists/Members/Instance.json"
+        },
+        {
+            "rel": "upsert",
+            "href": "https://us13.api.mailchimp.com/3.0/lists/0f6b836652/members/11bf13d1eb58116eba1de370b2bd796b",
+            "method": "PUT",
+            "schema": "https://us13.api.mailchimp.com/schema/3.0/Lists/Members/Instance.json"
+        },
+        {
+            "rel": "delete",
+            "href": "https://us13.api.mailchimp.com/3.0/lists/0f6b836652/members/11bf13d1eb58116eba1de370b2bd796b",
+            "method": "DELETE"
+        },
+        {
+            "rel": "activity",
+            "href": "https://us13.api.mailchimp.com/3.0/lists/0f6b836652/members/11bf13d1eb58116eba1de370b2bd796b/activity",
+            "method": "GET",
+            "targetSchema": "https://us13.api.mailchimp.com/schema/3.0/Lists/Members/Activity/Collection.json"
+        },
+        {
+            "rel": "goals",
+            "href": "https://us13.api.mailchimp.com/3.0/lists/0f6b836652/members/11bf13d1eb58116eba1de370b2bd796b/goals",
+            "method": "GET",
+            "targetSchema": "https://us13.api.mailchimp.com/schema/3.0/Lists/Members/Goals/Collection.json"
+        },
+        {
+            "rel": "notes",
+            "href": "https://us13.api.mailchimp.com/3.0/lists/0f6b836652/members/11bf13d1eb58116eba1de370b2bd796b/notes",
+            "method": "GET",
+            "targetSchema": "https://us13.api.mailchimp.com/schema/3.0/Lists/Members/Notes/Collection.json"
+        }
+    ]
+}`
+
+var unsubscribedResponse = `{
+    "id": "11bf13d1eb58116eba1de370b2bd796b",
+    "email_address": "john@reese.com",
+    "unique_email_id": "1b757e82a3",
+    "email_type": "html",
+    "status": "unsubscribed",
+    "merge_fields": {
+        "FNAME": "",
+        "LNAME": ""
+    },
+    "list_id": "0f6b836652"
+}`
+
+var pendingResponse = `{
+    "id": "a12bef585f1cae41a46e7edd45ade769",
+    "email_address": "john@reese.com",
+    "unique_email_id": "1b757e82a3",
+    "email_type": "html",
+    "status": "pending",
+    "list_id": "0f6b836652"
+}`
+
+var listMembersResponse = `{
+    "members": [
+        {
+            "id": "a12bef585f1cae41a46e7edd45ade769",
+            "email_address": "john@reese.com",
+            "status": "subscribed",
+            "list_id": "0f6b836652",
+            "marketing_permissions": [
+                {
+                    "marketing_permission_id": "a1b2c3",
+                    "text": "Email",
+                    "enabled": true
+                }
+            ]
+        },
+        {
+            "id": "0c6f3b6f0a4c6b9f3c138c9494a1d2b1",
+            "email_address": "harold@finch.com",
+            "status": "subscribed",
+            "list_id": "0f6b836652"
+        }
+    ],
+    "list_id": "0f6b836652",
+    "total_items": 42
+}`
+
+var memberNoteResponse = `{
+    "id": 7,
+    "created_at": "2018-10-11T10:17:25+00:00",
+    "created_by": "Harold Finch",
+    "updated_at": "2018-10-11T10:17:25+00:00",
+    "note": "Called about billing",
+    "list_id": "0f6b836652",
+    "email_id": "a12bef585f1cae41a46e7edd45ade769"
+}`
+
+var listResponse = `{
+    "id": "0f6b836652",
+    "web_id": 123456,
+    "name": "Customers",
+    "contact": {
+        "company": "Samaritan",
+        "address1": "1 Main St",
+        "address2": "",
+        "city": "New York",
+        "state": "NY",
+        "zip": "10001",
+        "country": "US",
+        "phone": ""
+    },
+    "permission_reminder": "You signed up on our website.",
+    "use_archive_bar": true,
+    "campaign_defaults": {
+        "from_name": "Harold Finch",
+        "from_email": "harold@finch.com",
+        "subject": "",
+        "language": "en"
+    },
+    "notify_on_subscribe": "",
+    "notify_on_unsubscribe": "",
+    "date_created": "2016-06-03T07:13:07+00:00",
+    "list_rating": 3,
+    "email_type_option": false,
+    "subscribe_url_short": "http://eepurl.com/abcdef",
+    "subscribe_url_long": "http://finch.us13.list-manage.com/subscribe?u=abc&id=0f6b836652",
+    "beamer_address": "us13-abc@inbound.mailchimp.com",
+    "visibility": "prv",
+    "double_optin": false,
+    "marketing_permissions": false,
+    "modules": [],
+    "stats": {
+        "member_count": 42,
+        "unsubscribe_count": 3,
+        "cleaned_count": 1,
+        "member_count_since_send": 2,
+        "unsubscribe_count_since_send": 0,
+        "cleaned_count_since_send": 0,
+        "campaign_count": 5,
+        "campaign_last_sent": "2018-10-11T10:17:25+00:00",
+        "merge_field_count": 4,
+        "avg_sub_rate": 1.5,
+        "avg_unsub_rate": 0.5,
+        "target_sub_rate": 2,
+        "open_rate": 25.5,
+        "click_rate": 3.25,
+        "last_sub_date": "2018-10-12T08:00:00+00:00",
+        "last_unsub_date": "2018-10-01T08:00:00+00:00"
+    }
+}`
+
+var mergeFieldResponse = `{
+    "merge_id": 5,
+    "tag": "PLAN",
+    "name": "Plan",
+    "type": "dropdown",
+    "required": false,
+    "default_value": "free",
+    "public": true,
+    "display_order": 6,
+    "options": {
+        "choices": ["free", "pro"]
+    },
+    "help_text": "",
+    "list_id": "0f6b836652"
+}`
+
+var interestCategoryResponse = `{
+    "list_id": "list_id",
+    "id": "a1e9f4b7f6",
+    "title": "Product line",
+    "display_order": 0,
+    "type": "checkboxes"
+}`
+
+var webhookResponse = `{
+    "id": "37b9bd8a20",
+    "url": "https://example.com/mailchimp/webhook",
+    "events": {
+        "subscribe": true,
+        "unsubscribe": true,
+        "profile": false,
+        "cleaned": true,
+        "upemail": false,
+        "campaign": false
+    },
+    "sources": {
+        "user": true,
+        "admin": true,
+        "api": false
+    },
+    "list_id": "list_id"
+}`
+
+var signupFormResponse = `{
+    "header": {
+        "text": "Join Acme",
+        "image_url": "https://example.com/logo.png"
+    },
+    "contents": [
+        {
+            "section": "signup_message",
+            "value": "Get the latest news from Acme."
+        }
+    ],
+    "styles": [
+        {
+            "selector": "page_background",
+            "options": [
+                {
+                    "property": "background-color",
+                    "value": "#ffffff"
+                }
+            ]
+        }
+    ],
+    "signup_form_url": "http://eepurl.com/abc123",
+    "list_id": "list_id"
+}`
+
+var growthHistoryResponse = `{
+    "list_id": "list_id",
+    "month": "2019-04",
+    "existing": 1200,
+    "imports": 40,
+    "optins": 85,
+    "subscribed": 1310,
+    "unsubscribed": 12,
+    "reconfirm": 0,
+    "cleaned": 3,
+    "pending": 7,
+    "deleted": 0,
+    "transactional": 2
+}`
+
+var abuseReportResponse = `{
+    "id": 8841,
+    "campaign_id": "42694e9e57",
+    "list_id": "list_id",
+    "email_id": "a12bef585f1cae41a46e7edd45ade769",
+    "email_address": "john@reese.com",
+    "merge_fields": {
+        "FNAME": "John"
+    },
+    "vip": false,
+    "date": "2019-04-02T10:31:08+00:00"
+}`
+
+var surveyResponse = `{
+    "id": "2e1c1f9a1b",
+    "web_id": 4121,
+    "list_id": "list_id",
+    "list_name": "Acme Customers",
+    "title": "Product feedback",
+    "url": "https://us1.list-manage.com/survey?u=abc&id=2e1c1f9a1b",
+    "status": "published",
+    "published_at": "2019-04-02T10:31:08+00:00",
+    "created_at": "2019-04-01T09:00:00+00:00",
+    "updated_at": "2019-04-02T10:31:08+00:00",
+    "total_responses": 17
+}`
+
+var surveyResponseResponse = `{
+    "response_id": "911",
+    "submitted_at": "2019-04-03T12:00:00+00:00",
+    "contact": {
+        "email_id": "a12bef585f1cae41a46e7edd45ade769",
+        "contact_id": "88e2c0a7",
+        "status": "subscribed",
+        "email": "john@reese.com",
+        "full_name": "John Reese"
+    },
+    "is_new_contact": false,
+    "results": [
+        {
+            "question_id": "q1",
+            "question_type": "pickOne",
+            "query": "How likely are you to recommend us?",
+            "answer": "Very likely"
+        }
+    ]
+}`
+
+var campaignResponse = `{
+    "id": "42694e9e57",
+    "web_id": 60789,
+    "type": "regular",
+    "create_time": "2019-04-01T09:00:00+00:00",
+    "archive_url": "http://eepurl.com/dxyz",
+    "long_archive_url": "https://us1.campaign-archive.com/?u=abc&id=42694e9e57",
+    "status": "save",
+    "emails_sent": 0,
+    "send_time": "",
+    "content_type": "html",
+    "recipients": {
+        "list_id": "list_id",
+        "list_name": "Customers",
+        "segment_text": "",
+        "recipient_count": 42
+    },
+    "settings": {
+        "subject_line": "April news",
+        "preview_text": "What we shipped this month",
+        "title": "April newsletter",
+        "from_name": "Harold Finch",
+        "reply_to": "harold@finch.com",
+        "to_name": "*|FNAME|*",
+        "template_id": 0
+    },
+    "tracking": {
+        "opens": true,
+        "html_clicks": true,
+        "text_clicks": false,
+        "goal_tracking": false,
+        "ecomm360": false,
+        "google_analytics": "april_news",
+        "clicktale": ""
+    }
+}`
+
+var campaignContentResponse = `{
+    "plain_text": "April news\n\nWhat we shipped this month",
+    "html": "<h1>April news</h1><p>What we shipped this month</p>",
+    "archive_html": "<html><body><h1>April news</h1></body></html>"
+}`
+
+var campaignNotReadyErrorResponse = `{
+    "type": "http://developer.mailchimp.com/documentation/mailchimp/guides/error-glossary/",
+    "title": "Bad Request",
+    "status": 400,
+    "detail": "Your Campaign is not ready to send.",
+    "instance": ""
+}`
+
+var campaignFeedbackResponse = `{
+    "feedback_id": 12,
+    "parent_campaign_id": "",
+    "block_id": 3,
+    "message": "Please fix the typo in the header.",
+    "is_complete": false,
+    "created_by": "Harold Finch",
+    "created_at": "2019-04-02T10:31:08+00:00",
+    "updated_at": "2019-04-02T10:31:08+00:00",
+    "source": "api",
+    "campaign_id": "42694e9e57"
+}`
+
+var templateResponse = `{
+    "id": 2000,
+    "type": "user",
+    "name": "Acme newsletter",
+    "drag_and_drop": false,
+    "responsive": true,
+    "category": "",
+    "date_created": "2019-04-01T09:00:00+00:00",
+    "date_edited": "2019-04-02T10:31:08+00:00",
+    "created_by": "harold",
+    "edited_by": "harold",
+    "active": true,
+    "folder_id": "",
+    "thumbnail": "",
+    "share_url": ""
+}`
+
+var reportResponse = `{
+    "id": "42694e9e57",
+    "campaign_title": "April newsletter",
+    "type": "regular",
+    "list_id": "list_id",
+    "list_is_active": true,
+    "list_name": "Customers",
+    "subject_line": "April news",
+    "preview_text": "What we shipped this month",
+    "emails_sent": 1200,
+    "abuse_reports": 1,
+    "unsubscribed": 4,
+    "send_time": "2019-04-02T13:45:00+00:00",
+    "rss_last_send": "",
+    "bounces": {
+        "hard_bounces": 2,
+        "soft_bounces": 5,
+        "syntax_errors": 0
+    },
+    "forwards": {
+        "forwards_count": 3,
+        "forwards_opens": 1
+    },
+    "opens": {
+        "opens_total": 520,
+        "unique_opens": 310,
+        "open_rate": 0.2592,
+        "last_open": "2019-04-05T08:12:00+00:00"
+    },
+    "clicks": {
+        "clicks_total": 64,
+        "unique_clicks": 50,
+        "unique_subscriber_clicks": 42,
+        "click_rate": 0.0351,
+        "last_click": "2019-04-04T19:02:00+00:00"
+    },
+    "list_stats": {
+        "sub_rate": 12,
+        "unsub_rate": 1,
+        "open_rate": 24.5,
+        "click_rate": 3.1
+    },
+    "timeseries": [
+        {
+            "timestamp": "2019-04-02T13:00:00+00:00",
+            "emails_sent": 1200,
+            "unique_opens": 120,
+            "recipients_clicks": 14
+        }
+    ],
+    "delivery_status": {
+        "enabled": true,
+        "can_cancel": false,
+        "status": "delivered",
+        "emails_sent": 1200,
+        "emails_canceled": 0
+    }
+}`
+
+var emailActivityResponse = `{
+    "campaign_id": "42694e9e57",
+    "list_id": "list_id",
+    "list_is_active": true,
+    "email_id": "a12bef585f1cae41a46e7edd45ade769",
+    "email_address": "john@reese.com",
+    "activity": [
+        {
+            "action": "open",
+            "timestamp": "2019-04-03T08:00:00+00:00",
+            "ip": "203.0.113.7"
+        },
+        {
+            "action": "click",
+            "timestamp": "2019-04-03T08:01:00+00:00",
+            "url": "https://example.com/pricing",
+            "ip": "203.0.113.7"
+        }
+    ]
+}`
+
+var sentToResponse = `{
+    "email_id": "a12bef585f1cae41a46e7edd45ade769",
+    "email_address": "john@reese.com",
+    "merge_fields": {
+        "FNAME": "John"
+    },
+    "vip": false,
+    "status": "sent",
+    "open_count": 2,
+    "last_open": "2019-04-04T18:30:00+00:00",
+    "absplit_group": "",
+    "gmt_offset": 0,
+    "campaign_id": "42694e9e57",
+    "list_id": "list_id",
+    "list_is_active": true
+}`
+
+var facebookAdResponse = `{
+    "id": "fb_outreach_1",
+    "name": "Spring sale",
+    "type": "facebook",
+    "status": "active",
+    "create_time": "2019-04-01T10:00:00+00:00",
+    "start_time": "2019-04-02T00:00:00+00:00",
+    "end_time": "2019-04-09T00:00:00+00:00",
+    "needs_attention": false,
+    "recipients": {
+        "list_id": "0f6b836652",
+        "list_name": "Customers"
+    },
+    "budget": {
+        "duration": 604800,
+        "total_amount": 70.5,
+        "currency_code": "USD"
+    },
+    "audience": {
+        "type": "Lookalike Audience",
+        "source_type": "list",
+        "include_source_in_target": false,
+        "lookalike_country_code": "US",
+        "targeting_specs": {
+            "locations": {"countries": ["US"]},
+            "gender": 0,
+            "min_age": 18,
+            "max_age": 65
+        }
+    },
+    "report_summary": {
+        "reach": 1200,
+        "impressions": 3400,
+        "clicks": 85,
+        "unique_clicks": 70,
+        "click_rate": 2.5,
+        "cost_per_click": 0.83,
+        "total_revenue": 420.0,
+        "currency_code": "USD"
+    }
+}`
+
+var landingPageResponse = `{
+    "id": "lp_0f6b8366",
+    "web_id": 1234,
+    "name": "Spring sale",
+    "title": "Spring sale - 20% off",
+    "description": "Sign up to get the discount code.",
+    "template_id": 1001,
+    "status": "published",
+    "list_id": "0f6b836652",
+    "store_id": "",
+    "url": "https://mailchi.mp/example/spring-sale",
+    "created_at": "2019-04-01T10:00:00+00:00",
+    "updated_at": "2019-04-01T11:00:00+00:00",
+    "published_at": "2019-04-01T11:00:00+00:00",
+    "unpublished_at": "",
+    "created_by_source": "api",
+    "tracking": {
+        "track_with_mailchimp": true,
+        "enable_restricted_data_processing": false
+    }
+}`
+
+var landingPageReportResponse = `{
+    "id": "lp_0f6b8366",
+    "name": "Spring sale",
+    "url": "https://mailchi.mp/example/spring-sale",
+    "status": "published",
+    "list_id": "0f6b836652",
+    "visits": 320,
+    "unique_visits": 250,
+    "subscribes": 40,
+    "clicks": 95,
+    "conversion_rate": 16,
+    "ecommerce": {"total_revenue": 0, "currency_code": "USD", "total_orders": 0}
+}`
+
+var automationResponse = `{
+    "id": "4e3d2a1b0c",
+    "create_time": "2019-03-01T09:00:00+00:00",
+    "start_time": "2019-03-02T09:00:00+00:00",
+    "status": "sending",
+    "emails_sent": 512,
+    "recipients": {
+        "list_id": "0f6b836652",
+        "list_is_active": true,
+        "list_name": "Customers"
+    },
+    "settings": {
+        "title": "Welcome series",
+        "from_name": "Harold Finch",
+        "reply_to": "harold@finch.com",
+        "use_conversation": false,
+        "to_name": "*|FNAME|*",
+        "authenticate": true,
+        "auto_footer": false,
+        "inline_css": false
+    },
+    "tracking": {
+        "opens": true,
+        "html_clicks": true,
+        "text_clicks": false,
+        "goal_tracking": false,
+        "ecomm360": false,
+        "google_analytics": "",
+        "clicktale": ""
+    },
+    "trigger_settings": {
+        "workflow_type": "emailSeries",
+        "workflow_title": "Welcome new subscribers",
+        "runtime": {
+            "days": ["monday", "tuesday", "wednesday", "thursday", "friday"],
+            "hours": {"type": "send_between", "send_between": {"start": "09:00", "end": "17:00"}}
+        },
+        "workflow_emails_count": 3
+    },
+    "report_summary": {
+        "opens": 300,
+        "unique_opens": 240,
+        "open_rate": 46.9,
+        "clicks": 80,
+        "subscriber_clicks": 60,
+        "click_rate": 11.7
+    }
+}`
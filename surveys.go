@@ -0,0 +1,164 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Survey - see https://mailchimp.com/developer/marketing/api/surveys/
+type Survey struct {
+	ID             string `json:"id"`
+	WebID          int    `json:"web_id"` // The ID used in the Mailchimp web application.
+	ListID         string `json:"list_id"`
+	ListName       string `json:"list_name"`
+	Title          string `json:"title"`
+	URL            string `json:"url"`    // The URL of the hosted survey.
+	Status         string `json:"status"` // Either published or unpublished.
+	PublishedAt    string `json:"published_at"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+	TotalResponses int    `json:"total_responses"`
+}
+
+// ListSurveysResponse ...
+type ListSurveysResponse struct {
+	Surveys    []Survey `json:"surveys"`
+	TotalItems int      `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// SurveyContact - the contact who responded to a survey
+type SurveyContact struct {
+	EmailID   string `json:"email_id"` // The MD5 hash of the lowercase version of the contact's email address.
+	ContactID string `json:"contact_id"`
+	Status    string `json:"status"`
+	Email     string `json:"email"`
+	FullName  string `json:"full_name"`
+}
+
+// SurveyResult - the answer to a single survey question
+type SurveyResult struct {
+	QuestionID   string `json:"question_id"`
+	QuestionType string `json:"question_type"` // e.g. pickOne, pickMany, range, text, email.
+	Query        string `json:"query"`         // The question as it was asked.
+	Answer       string `json:"answer"`
+}
+
+// SurveyResponse - a single response to a survey
+type SurveyResponse struct {
+	ResponseID   string         `json:"response_id"`
+	SubmittedAt  string         `json:"submitted_at"`
+	Contact      SurveyContact  `json:"contact"`
+	IsNewContact bool           `json:"is_new_contact"` // Whether the contact was added to the list by responding.
+	Results      []SurveyResult `json:"results"`        // Only returned by GetSurveyResponse.
+}
+
+// SurveyResponsesParams ...
+type SurveyResponsesParams struct {
+	QuestionID              string // Return only responses to this question.
+	ChoseAnswer             string // Return only responses which chose this answer, requires QuestionID.
+	RespondentFamiliarityIs string // Either new, known or unknown.
+}
+
+// ListSurveyResponsesResponse ...
+type ListSurveyResponsesResponse struct {
+	Responses  []SurveyResponse `json:"responses"`
+	SurveyID   string           `json:"survey_id"`
+	TotalItems int              `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// ListSurveys returns the surveys of a list
+func (c *Client) ListSurveys(listID string) (*ListSurveysResponse, error) {
+	listSurveysResponse := new(ListSurveysResponse)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/surveys", listID),
+		nil,
+		listSurveysResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listSurveysResponse, nil
+}
+
+// GetSurvey returns a specific survey of a list
+func (c *Client) GetSurvey(listID string, surveyID string) (*Survey, error) {
+	survey := new(Survey)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/surveys/%s", listID, surveyID),
+		nil,
+		survey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return survey, nil
+}
+
+// PublishSurvey publishes a survey so it starts accepting responses
+func (c *Client) PublishSurvey(listID string, surveyID string) (*Survey, error) {
+	return c.surveyAction(listID, surveyID, "publish")
+}
+
+// UnpublishSurvey unpublishes a survey so it stops accepting responses
+func (c *Client) UnpublishSurvey(listID string, surveyID string) (*Survey, error) {
+	return c.surveyAction(listID, surveyID, "unpublish")
+}
+
+func (c *Client) surveyAction(listID string, surveyID string, action string) (*Survey, error) {
+	survey := new(Survey)
+	err := c.request(
+		"POST",
+		fmt.Sprintf("/lists/%s/surveys/%s/actions/%s", listID, surveyID, action),
+		nil,
+		survey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return survey, nil
+}
+
+// ListSurveyResponses returns the responses to a survey
+func (c *Client) ListSurveyResponses(surveyID string, params *SurveyResponsesParams) (*ListSurveyResponsesResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.QuestionID != "" {
+			query.Set("question_id", params.QuestionID)
+		}
+		if params.ChoseAnswer != "" {
+			query.Set("chose_answer", params.ChoseAnswer)
+		}
+		if params.RespondentFamiliarityIs != "" {
+			query.Set("respondent_familiarity_is", params.RespondentFamiliarityIs)
+		}
+	}
+	listSurveyResponsesResponse := new(ListSurveyResponsesResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/reporting/surveys/%s/responses", surveyID), query),
+		nil,
+		listSurveyResponsesResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listSurveyResponsesResponse, nil
+}
+
+// GetSurveyResponse returns a specific response to a survey, including the
+// answer to every question
+func (c *Client) GetSurveyResponse(surveyID string, responseID string) (*SurveyResponse, error) {
+	surveyResponse := new(SurveyResponse)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/reporting/surveys/%s/responses/%s", surveyID, responseID),
+		nil,
+		surveyResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return surveyResponse, nil
+}
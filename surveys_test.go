@@ -0,0 +1,92 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListSurveys(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/surveys", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"surveys": [%s], "total_items": 1}`, surveyResponse)
+	})
+	defer server.Close()
+
+	listSurveysResponse, err := client.ListSurveys("list_id")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, listSurveysResponse.TotalItems)
+	assert.Equal(t, "Product feedback", listSurveysResponse.Surveys[0].Title)
+	assert.Equal(t, 17, listSurveysResponse.Surveys[0].TotalResponses)
+}
+
+func TestPublishSurvey(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists/list_id/surveys/2e1c1f9a1b/actions/publish", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, surveyResponse)
+	})
+	defer server.Close()
+
+	survey, err := client.PublishSurvey("list_id", "2e1c1f9a1b")
+	assert.NoError(t, err)
+	assert.Equal(t, "published", survey.Status)
+}
+
+func TestUnpublishSurvey(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists/list_id/surveys/2e1c1f9a1b/actions/unpublish", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, surveyResponse)
+	})
+	defer server.Close()
+
+	_, err := client.UnpublishSurvey("list_id", "2e1c1f9a1b")
+	assert.NoError(t, err)
+}
+
+func TestListSurveyResponses(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reporting/surveys/2e1c1f9a1b/responses", req.URL.Path)
+		assert.Equal(t, "chose_answer=Very+likely&question_id=q1", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"responses": [%s], "survey_id": "2e1c1f9a1b", "total_items": 1}`, surveyResponseResponse)
+	})
+	defer server.Close()
+
+	listSurveyResponsesResponse, err := client.ListSurveyResponses("2e1c1f9a1b", &mailchimp.SurveyResponsesParams{
+		QuestionID:  "q1",
+		ChoseAnswer: "Very likely",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, listSurveyResponsesResponse.TotalItems)
+	assert.Equal(t, "john@reese.com", listSurveyResponsesResponse.Responses[0].Contact.Email)
+}
+
+func TestGetSurveyResponse(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/reporting/surveys/2e1c1f9a1b/responses/911", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, surveyResponseResponse)
+	})
+	defer server.Close()
+
+	response, err := client.GetSurveyResponse("2e1c1f9a1b", "911")
+	assert.NoError(t, err)
+	assert.Len(t, response.Results, 1)
+	assert.Equal(t, "Very likely", response.Results[0].Answer)
+}
@@ -0,0 +1,38 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Tag - a tag which exists on a list
+type Tag struct {
+	ID   int    `json:"id"` // The ID of the static segment backing the tag.
+	Name string `json:"name"`
+}
+
+// TagSearchResponse ...
+type TagSearchResponse struct {
+	Tags       []Tag `json:"tags"`
+	TotalItems int   `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// SearchTags returns the tags of a list whose names match the name. Pass an
+// empty name to return all tags.
+func (c *Client) SearchTags(listID string, name string) (*TagSearchResponse, error) {
+	query := url.Values{}
+	if name != "" {
+		query.Set("name", name)
+	}
+	tagSearchResponse := new(TagSearchResponse)
+	err := c.request(
+		"GET",
+		withQuery(fmt.Sprintf("/lists/%s/tag-search", listID), query),
+		nil,
+		tagSearchResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return tagSearchResponse, nil
+}
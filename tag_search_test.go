@@ -0,0 +1,27 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTags(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/tag-search", req.URL.Path)
+		assert.Equal(t, "name=cust", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"tags": [{"id": 17, "name": "Customer"}], "total_items": 1}`)
+	})
+	defer server.Close()
+
+	tagSearchResponse, err := client.SearchTags("list_id", "cust")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, tagSearchResponse.TotalItems)
+	assert.Equal(t, []mailchimp.Tag{{ID: 17, Name: "Customer"}}, tagSearchResponse.Tags)
+}
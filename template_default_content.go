@@ -0,0 +1,41 @@
+package mailchimp
+
+import (
+	"fmt"
+	"sort"
+)
+
+// TemplateDefaultContent - the editable sections of a template and their
+// default content
+type TemplateDefaultContent struct {
+	Sections map[string]string `json:"sections"` // The default HTML of each editable section, keyed by section name.
+}
+
+// UnknownSections returns the sorted names of the sections which are not
+// editable sections of the template. Mailchimp silently drops the content
+// of such sections when setting campaign content.
+func (d *TemplateDefaultContent) UnknownSections(sections map[string]string) []string {
+	var unknown []string
+	for name := range sections {
+		if _, ok := d.Sections[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// GetTemplateDefaultContent returns the editable sections of a template
+func (c *Client) GetTemplateDefaultContent(templateID int) (*TemplateDefaultContent, error) {
+	templateDefaultContent := new(TemplateDefaultContent)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/templates/%d/default-content", templateID),
+		nil,
+		templateDefaultContent,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return templateDefaultContent, nil
+}
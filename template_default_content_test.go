@@ -0,0 +1,32 @@
+package mailchimp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTemplateDefaultContent(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/templates/2000/default-content", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"sections": {"header": "<h1>Title</h1>", "body": "<p>Body</p>"}}`)
+	})
+	defer server.Close()
+
+	templateDefaultContent, err := client.GetTemplateDefaultContent(2000)
+	assert.NoError(t, err)
+	assert.Equal(t, "<p>Body</p>", templateDefaultContent.Sections["body"])
+
+	unknown := templateDefaultContent.UnknownSections(map[string]string{
+		"body":   "<p>Hello</p>",
+		"footer": "<p>Bye</p>",
+		"aside":  "<p>More</p>",
+	})
+	assert.Equal(t, []string{"aside", "footer"}, unknown)
+	assert.Empty(t, templateDefaultContent.UnknownSections(map[string]string{"header": ""}))
+}
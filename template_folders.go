@@ -0,0 +1,93 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// ListTemplateFoldersResponse ...
+type ListTemplateFoldersResponse struct {
+	Folders    []Folder `json:"folders"`
+	TotalItems int      `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// ListTemplateFolders returns a page of the template folders in the account
+func (c *Client) ListTemplateFolders(params *PaginationParams) (*ListTemplateFoldersResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+	}
+	listTemplateFoldersResponse := new(ListTemplateFoldersResponse)
+	err := c.request(
+		"GET",
+		withQuery("/template-folders", query),
+		nil,
+		listTemplateFoldersResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listTemplateFoldersResponse, nil
+}
+
+// GetTemplateFolder returns a specific template folder
+func (c *Client) GetTemplateFolder(folderID string) (*Folder, error) {
+	folder := new(Folder)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/template-folders/%s", folderID),
+		nil,
+		folder,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return folder, nil
+}
+
+// CreateTemplateFolder creates a new template folder
+func (c *Client) CreateTemplateFolder(name string) (*Folder, error) {
+	params := map[string]interface{}{
+		"name": name,
+	}
+	folder := new(Folder)
+	err := c.request(
+		"POST",
+		"/template-folders",
+		&params,
+		folder,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return folder, nil
+}
+
+// UpdateTemplateFolder renames a specific template folder
+func (c *Client) UpdateTemplateFolder(folderID string, name string) (*Folder, error) {
+	params := map[string]interface{}{
+		"name": name,
+	}
+	folder := new(Folder)
+	err := c.request(
+		"PATCH",
+		fmt.Sprintf("/template-folders/%s", folderID),
+		&params,
+		folder,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return folder, nil
+}
+
+// DeleteTemplateFolder deletes a specific template folder. The templates in
+// the folder are kept and marked as unfiled.
+func (c *Client) DeleteTemplateFolder(folderID string) error {
+	return c.request(
+		"DELETE",
+		fmt.Sprintf("/template-folders/%s", folderID),
+		nil,
+		nil,
+	)
+}
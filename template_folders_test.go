@@ -0,0 +1,79 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListTemplateFolders(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/template-folders", req.URL.Path)
+		assert.Equal(t, "count=100", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"folders": [{"id": "f1", "name": "acme/newsletters", "count": 3}], "total_items": 1}`)
+	})
+	defer server.Close()
+
+	listTemplateFoldersResponse, err := client.ListTemplateFolders(&mailchimp.PaginationParams{Count: 100})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, listTemplateFoldersResponse.TotalItems)
+	assert.Equal(t, "acme/newsletters", listTemplateFoldersResponse.Folders[0].Name)
+	assert.Equal(t, 3, listTemplateFoldersResponse.Folders[0].Count)
+}
+
+func TestCreateTemplateFolder(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/template-folders", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{"name": "acme/newsletters"}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"id": "f1", "name": "acme/newsletters", "count": 0}`)
+	})
+	defer server.Close()
+
+	folder, err := client.CreateTemplateFolder("acme/newsletters")
+	assert.NoError(t, err)
+	assert.Equal(t, "f1", folder.ID)
+}
+
+func TestUpdateTemplateFolder(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "/template-folders/f1", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{"name": "acme/promotions"}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, `{"id": "f1", "name": "acme/promotions", "count": 3}`)
+	})
+	defer server.Close()
+
+	folder, err := client.UpdateTemplateFolder("f1", "acme/promotions")
+	assert.NoError(t, err)
+	assert.Equal(t, "acme/promotions", folder.Name)
+}
+
+func TestDeleteTemplateFolder(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/template-folders/f1", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.DeleteTemplateFolder("f1"))
+}
@@ -0,0 +1,181 @@
+package mailchimp
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"time"
+)
+
+// MaxTemplateSize is the largest template HTML, in bytes, accepted by
+// CreateTemplateFromReader and CreateTemplateFromFile
+const MaxTemplateSize = 1 << 20
+
+// Template types
+const (
+	TemplateUser    = "user"    // Created in the account.
+	TemplateBase    = "base"    // Provided by Mailchimp as a starting point.
+	TemplateGallery = "gallery" // Provided by Mailchimp's template gallery.
+)
+
+// Template - see https://developer.mailchimp.com/documentation/mailchimp/reference/templates/
+type Template struct {
+	ID          int    `json:"id"`
+	Type        string `json:"type"` // One of TemplateUser, TemplateBase or TemplateGallery.
+	Name        string `json:"name"`
+	DragAndDrop bool   `json:"drag_and_drop"` // Whether the template uses the drag and drop editor.
+	Responsive  bool   `json:"responsive"`    // Whether the template contains media queries to make it responsive.
+	Category    string `json:"category"`      // The category of gallery templates.
+	DateCreated string `json:"date_created"`
+	DateEdited  string `json:"date_edited"`
+	CreatedBy   string `json:"created_by"` // The login name of the template's creator.
+	EditedBy    string `json:"edited_by"`
+	Active      bool   `json:"active"`
+	FolderID    string `json:"folder_id"`
+	Thumbnail   string `json:"thumbnail"` // The URL of the thumbnail of the template.
+	ShareURL    string `json:"share_url"`
+}
+
+// TemplateParams - used both to create and to update a template. When
+// updating, fields left empty are not changed.
+type TemplateParams struct {
+	Name     string `json:"name,omitempty"`
+	HTML     string `json:"html,omitempty"`      // The raw HTML of the template, using Mailchimp's template language.
+	FolderID string `json:"folder_id,omitempty"` // The template folder to file the template in.
+}
+
+// ListTemplatesParams ...
+type ListTemplatesParams struct {
+	PaginationParams
+	Type              string    // One of TemplateUser, TemplateBase or TemplateGallery.
+	Category          string    // Restrict gallery templates to this category.
+	FolderID          string    // Restrict templates to this folder.
+	CreatedBy         string    // Restrict templates to those created by this login name.
+	SinceDateCreated  time.Time // Restrict templates to those created after the set time.
+	BeforeDateCreated time.Time // Restrict templates to those created before the set time.
+}
+
+// ListTemplatesResponse ...
+type ListTemplatesResponse struct {
+	Templates  []Template `json:"templates"`
+	TotalItems int        `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// ListTemplates returns a page of the templates available to the account
+func (c *Client) ListTemplates(params *ListTemplatesParams) (*ListTemplatesResponse, error) {
+	query := url.Values{}
+	if params != nil {
+		params.addTo(query)
+		if params.Type != "" {
+			query.Set("type", params.Type)
+		}
+		if params.Category != "" {
+			query.Set("category", params.Category)
+		}
+		if params.FolderID != "" {
+			query.Set("folder_id", params.FolderID)
+		}
+		if params.CreatedBy != "" {
+			query.Set("created_by", params.CreatedBy)
+		}
+		setTime(query, "since_date_created", params.SinceDateCreated)
+		setTime(query, "before_date_created", params.BeforeDateCreated)
+	}
+	listTemplatesResponse := new(ListTemplatesResponse)
+	err := c.request(
+		"GET",
+		withQuery("/templates", query),
+		nil,
+		listTemplatesResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listTemplatesResponse, nil
+}
+
+// GetTemplate returns information about a specific template
+func (c *Client) GetTemplate(templateID int) (*Template, error) {
+	template := new(Template)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/templates/%d", templateID),
+		nil,
+		template,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// CreateTemplate creates a new user template from custom HTML
+func (c *Client) CreateTemplate(params *TemplateParams) (*Template, error) {
+	template := new(Template)
+	err := c.request(
+		"POST",
+		"/templates",
+		params,
+		template,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// CreateTemplateFromReader creates a new user template from the HTML read
+// from r. HTML larger than MaxTemplateSize returns ErrTemplateTooLarge
+// without calling the API.
+func (c *Client) CreateTemplateFromReader(name string, r io.Reader, folderID string) (*Template, error) {
+	// Read one byte more than allowed to detect oversized HTML
+	html, err := ioutil.ReadAll(io.LimitReader(r, MaxTemplateSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(html) > MaxTemplateSize {
+		return nil, ErrTemplateTooLarge
+	}
+	return c.CreateTemplate(&TemplateParams{
+		Name:     name,
+		HTML:     string(html),
+		FolderID: folderID,
+	})
+}
+
+// CreateTemplateFromFile creates a new user template from the HTML file at path
+func (c *Client) CreateTemplateFromFile(name string, path string, folderID string) (*Template, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return c.CreateTemplateFromReader(name, f, folderID)
+}
+
+// UpdateTemplate updates the name or HTML of a user template
+func (c *Client) UpdateTemplate(templateID int, params *TemplateParams) (*Template, error) {
+	template := new(Template)
+	err := c.request(
+		"PATCH",
+		fmt.Sprintf("/templates/%d", templateID),
+		params,
+		template,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// DeleteTemplate deletes a user template
+func (c *Client) DeleteTemplate(templateID int) error {
+	return c.request(
+		"DELETE",
+		fmt.Sprintf("/templates/%d", templateID),
+		nil,
+		nil,
+	)
+}
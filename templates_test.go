@@ -0,0 +1,190 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListTemplates(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/templates", req.URL.Path)
+		assert.Equal(t, "count=100", req.URL.RawQuery)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"templates": [%s], "total_items": 1}`, templateResponse)
+	})
+	defer server.Close()
+
+	listTemplatesResponse, err := client.ListTemplates(&mailchimp.ListTemplatesParams{
+		PaginationParams: mailchimp.PaginationParams{Count: 100},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, listTemplatesResponse.TotalItems)
+	assert.Equal(t, mailchimp.TemplateUser, listTemplatesResponse.Templates[0].Type)
+}
+
+func TestListTemplatesFilters(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/templates", req.URL.Path)
+		assert.Equal(
+			t,
+			"created_by=harold&folder_id=f1&since_date_created=2019-04-01T08%3A00%3A00%2B00%3A00&type=user",
+			req.URL.RawQuery,
+		)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"templates": [%s], "total_items": 1}`, templateResponse)
+	})
+	defer server.Close()
+
+	_, err := client.ListTemplates(&mailchimp.ListTemplatesParams{
+		Type:             mailchimp.TemplateUser,
+		FolderID:         "f1",
+		CreatedBy:        "harold",
+		SinceDateCreated: time.Date(2019, 4, 1, 10, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetTemplate(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/templates/2000", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, templateResponse)
+	})
+	defer server.Close()
+
+	template, err := client.GetTemplate(2000)
+	assert.NoError(t, err)
+	assert.Equal(t, "Acme newsletter", template.Name)
+	assert.True(t, template.Responsive)
+}
+
+func TestCreateTemplate(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/templates", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"name":      "Acme newsletter",
+			"html":      `<div mc:edit="body"></div>`,
+			"folder_id": "f1",
+		}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, templateResponse)
+	})
+	defer server.Close()
+
+	template, err := client.CreateTemplate(&mailchimp.TemplateParams{
+		Name:     "Acme newsletter",
+		HTML:     `<div mc:edit="body"></div>`,
+		FolderID: "f1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2000, template.ID)
+}
+
+func TestCreateTemplateFromReader(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/templates", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"name": "Acme newsletter",
+			"html": "<html><body>Compiled</body></html>",
+		}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, templateResponse)
+	})
+	defer server.Close()
+
+	template, err := client.CreateTemplateFromReader("Acme newsletter", strings.NewReader("<html><body>Compiled</body></html>"), "")
+	assert.NoError(t, err)
+	assert.Equal(t, 2000, template.ID)
+}
+
+func TestCreateTemplateFromReaderTooLarge(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		t.Error("the API should not be called")
+	})
+	defer server.Close()
+
+	html := strings.Repeat("a", mailchimp.MaxTemplateSize+1)
+	_, err := client.CreateTemplateFromReader("Acme newsletter", strings.NewReader(html), "")
+	assert.Equal(t, mailchimp.ErrTemplateTooLarge, err)
+}
+
+func TestCreateTemplateFromFile(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, "<p>From file</p>", params["html"])
+		assert.Equal(t, "f1", params["folder_id"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, templateResponse)
+	})
+	defer server.Close()
+
+	f, err := ioutil.TempFile("", "template")
+	assert.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString("<p>From file</p>")
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	_, err = client.CreateTemplateFromFile("Acme newsletter", f.Name(), "f1")
+	assert.NoError(t, err)
+
+	_, err = client.CreateTemplateFromFile("Acme newsletter", f.Name()+".missing", "f1")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestUpdateTemplate(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "/templates/2000", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{"html": `<div mc:edit="main"></div>`}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, templateResponse)
+	})
+	defer server.Close()
+
+	_, err := client.UpdateTemplate(2000, &mailchimp.TemplateParams{HTML: `<div mc:edit="main"></div>`})
+	assert.NoError(t, err)
+}
+
+func TestDeleteTemplate(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/templates/2000", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.DeleteTemplate(2000))
+}
@@ -0,0 +1,25 @@
+package mailchimp
+
+import (
+	"fmt"
+
+	"github.com/RichardKnop/go-mailchimp/status"
+)
+
+// Unsubscribe sets the status of an existing list member to unsubscribed
+func (c *Client) Unsubscribe(listID string, email string) (*MemberResponse, error) {
+	params := map[string]interface{}{
+		"status": status.Unsubscribed,
+	}
+	memberResponse := new(MemberResponse)
+	err := c.request(
+		"PATCH",
+		fmt.Sprintf("/lists/%s/members/%s", listID, SubscriberHash(email)),
+		&params,
+		memberResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return memberResponse, nil
+}
@@ -0,0 +1,47 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/RichardKnop/go-mailchimp/status"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnsubscribe(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, string(status.Unsubscribed), params["status"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, unsubscribedResponse)
+	})
+	defer server.Close()
+
+	memberResponse, err := client.Unsubscribe("list_id", "John@Reese.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "john@reese.com", memberResponse.EmailAddress)
+	assert.Equal(t, status.Unsubscribed, memberResponse.Status)
+}
+
+func TestUnsubscribeNotFoundError(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(404)
+		fmt.Fprint(rw, notFoundErrorResponse)
+	})
+	defer server.Close()
+
+	memberResponse, err := client.Unsubscribe("list_id", "john@reese.com")
+	assert.Nil(t, memberResponse)
+
+	errResponse, ok := err.(*mailchimp.ErrorResponse)
+	assert.True(t, ok)
+	assert.Equal(t, 404, errResponse.Status)
+}
@@ -0,0 +1,41 @@
+package mailchimp
+
+import (
+	"fmt"
+
+	"github.com/RichardKnop/go-mailchimp/status"
+)
+
+// UpdateMemberParams - see https://developer.mailchimp.com/documentation/mailchimp/reference/lists/members/#edit-patch_lists_list_id_members_subscriber_hash
+// Only non empty fields are sent, so fields left empty are not changed.
+type UpdateMemberParams struct {
+	EmailType   string                 `json:"email_type,omitempty"` // Type of email this member asked to get ('html' or 'text').
+	Status      status.MemberStatus    `json:"status,omitempty"`
+	MergeFields map[string]interface{} `json:"merge_fields,omitempty"`
+	Interests   map[string]bool        `json:"interests,omitempty"` // Set an interest ID to false to remove the member from that group.
+	Language    string                 `json:"language,omitempty"`  // If set/detected, the subscriber's language.
+	VIP         *bool                  `json:"vip,omitempty"`       // Pointer so that VIP status can be removed as well as set.
+	Location    *MemberLocation        `json:"location,omitempty"`
+
+	MarketingPermissions []MarketingPermission `json:"marketing_permissions,omitempty"`
+}
+
+// UpdateMember partially updates an existing list member
+func (c *Client) UpdateMember(listID string, email string, params *UpdateMemberParams) (*MemberResponse, error) {
+	memberResponse := new(MemberResponse)
+	err := c.request(
+		"PATCH",
+		fmt.Sprintf("/lists/%s/members/%s", listID, SubscriberHash(email)),
+		params,
+		memberResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return memberResponse, nil
+}
+
+// SetMemberVIP adds or removes VIP status of a list member
+func (c *Client) SetMemberVIP(listID string, email string, vip bool) (*MemberResponse, error) {
+	return c.UpdateMember(listID, email, &UpdateMemberParams{VIP: &vip})
+}
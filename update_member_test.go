@@ -0,0 +1,113 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateMember(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"merge_fields": map[string]interface{}{"FNAME": "John"},
+			"vip":          false,
+		}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, successResponse)
+	})
+	defer server.Close()
+
+	vip := false
+	memberResponse, err := client.UpdateMember("list_id", "john@reese.com", &mailchimp.UpdateMemberParams{
+		MergeFields: map[string]interface{}{"FNAME": "John"},
+		VIP:         &vip,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "john@reese.com", memberResponse.EmailAddress)
+}
+
+func TestUpdateMemberError(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(400)
+		fmt.Fprint(rw, invalidMergeFieldsErrorResponse)
+	})
+	defer server.Close()
+
+	memberResponse, err := client.UpdateMember("list_id", "john@reese.com", &mailchimp.UpdateMemberParams{})
+	assert.Nil(t, memberResponse)
+	assert.Equal(t, "Error 400 Invalid Resource (Your merge fields were invalid.)", err.Error())
+}
+
+func TestSetMemberVIP(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "PATCH", req.Method)
+		assert.Equal(t, "/lists/list_id/members/a12bef585f1cae41a46e7edd45ade769", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{"vip": true}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, successResponse)
+	})
+	defer server.Close()
+
+	_, err := client.SetMemberVIP("list_id", "john@reese.com", true)
+	assert.NoError(t, err)
+}
+
+func TestUpdateMemberInterests(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"interests": map[string]interface{}{"9143cf3bd1": true, "3a2a927344": false},
+		}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, successResponse)
+	})
+	defer server.Close()
+
+	_, err := client.UpdateMember("list_id", "john@reese.com", &mailchimp.UpdateMemberParams{
+		Interests: map[string]bool{"9143cf3bd1": true, "3a2a927344": false},
+	})
+	assert.NoError(t, err)
+}
+
+func TestUpdateMemberLocation(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, map[string]interface{}{
+			"location": map[string]interface{}{"latitude": 40.7128, "longitude": -74.006},
+		}, params)
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, memberWithStatsResponse)
+	})
+	defer server.Close()
+
+	memberResponse, err := client.UpdateMember("list_id", "john@reese.com", &mailchimp.UpdateMemberParams{
+		Location: &mailchimp.MemberLocation{Latitude: 40.7128, Longitude: -74.006},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, mailchimp.MemberLocation{
+		Latitude:    40.7128,
+		Longitude:   -74.006,
+		GMTOff:      -5,
+		DSTOff:      -4,
+		CountryCode: "US",
+		Timezone:    "America/New_York",
+	}, memberResponse.Location)
+}
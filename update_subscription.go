@@ -1,7 +1,6 @@
 package mailchimp
 
 import (
-	"crypto/md5"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -11,8 +10,6 @@ import (
 
 // UpdateSubscription ...
 func (c *Client) UpdateSubscription(listID string, email string, mergeFields map[string]interface{}) (*MemberResponse, error) {
-	// Hash email
-	emailMD5 := fmt.Sprintf("%x", md5.Sum([]byte(email)))
 	// Make request
 	params := map[string]interface{}{
 		"email_address": email,
@@ -21,7 +18,7 @@ func (c *Client) UpdateSubscription(listID string, email string, mergeFields map
 	}
 	resp, err := c.do(
 		"PUT",
-		fmt.Sprintf("/lists/%s/members/%s", listID, emailMD5),
+		fmt.Sprintf("/lists/%s/members/%s", listID, SubscriberHash(email)),
 		&params,
 	)
 	if err != nil {
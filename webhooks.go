@@ -0,0 +1,115 @@
+package mailchimp
+
+import (
+	"fmt"
+)
+
+// WebhookEvents - the events that trigger a webhook
+type WebhookEvents struct {
+	Subscribe   bool `json:"subscribe"`
+	Unsubscribe bool `json:"unsubscribe"`
+	Profile     bool `json:"profile"` // A subscriber updated their profile.
+	Cleaned     bool `json:"cleaned"`
+	Upemail     bool `json:"upemail"`  // A subscriber changed their email address.
+	Campaign    bool `json:"campaign"` // A campaign was sent or cancelled.
+}
+
+// WebhookSources - the possible sources of events that trigger a webhook
+type WebhookSources struct {
+	User  bool `json:"user"`  // Changes made by subscribers.
+	Admin bool `json:"admin"` // Changes made by account admins.
+	API   bool `json:"api"`   // Changes made via the API.
+}
+
+// Webhook - see https://developer.mailchimp.com/documentation/mailchimp/reference/lists/webhooks/
+type Webhook struct {
+	ID      string         `json:"id"`
+	URL     string         `json:"url"`
+	Events  WebhookEvents  `json:"events"`
+	Sources WebhookSources `json:"sources"`
+	ListID  string         `json:"list_id"`
+}
+
+// WebhookParams - used both to create and to update a webhook
+type WebhookParams struct {
+	URL     string          `json:"url,omitempty"`
+	Events  *WebhookEvents  `json:"events,omitempty"`
+	Sources *WebhookSources `json:"sources,omitempty"`
+}
+
+// ListWebhooksResponse ...
+type ListWebhooksResponse struct {
+	Webhooks   []Webhook `json:"webhooks"`
+	ListID     string    `json:"list_id"`
+	TotalItems int       `json:"total_items"` // The total number of items matching the query regardless of pagination.
+}
+
+// ListWebhooks returns the webhooks of a list
+func (c *Client) ListWebhooks(listID string) (*ListWebhooksResponse, error) {
+	listWebhooksResponse := new(ListWebhooksResponse)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/webhooks", listID),
+		nil,
+		listWebhooksResponse,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listWebhooksResponse, nil
+}
+
+// GetWebhook returns a specific webhook of a list
+func (c *Client) GetWebhook(listID string, webhookID string) (*Webhook, error) {
+	webhook := new(Webhook)
+	err := c.request(
+		"GET",
+		fmt.Sprintf("/lists/%s/webhooks/%s", listID, webhookID),
+		nil,
+		webhook,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// CreateWebhook adds a new webhook to a list
+func (c *Client) CreateWebhook(listID string, params *WebhookParams) (*Webhook, error) {
+	webhook := new(Webhook)
+	err := c.request(
+		"POST",
+		fmt.Sprintf("/lists/%s/webhooks", listID),
+		params,
+		webhook,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// UpdateWebhook updates a specific webhook of a list
+func (c *Client) UpdateWebhook(listID string, webhookID string, params *WebhookParams) (*Webhook, error) {
+	webhook := new(Webhook)
+	err := c.request(
+		"PATCH",
+		fmt.Sprintf("/lists/%s/webhooks/%s", listID, webhookID),
+		params,
+		webhook,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// DeleteWebhook deletes a specific webhook of a list
+func (c *Client) DeleteWebhook(listID string, webhookID string) error {
+	return c.request(
+		"DELETE",
+		fmt.Sprintf("/lists/%s/webhooks/%s", listID, webhookID),
+		nil,
+		nil,
+	)
+}
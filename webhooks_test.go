@@ -0,0 +1,66 @@
+package mailchimp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	mailchimp "github.com/RichardKnop/go-mailchimp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/lists/list_id/webhooks", req.URL.Path)
+
+		var params map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&params))
+		assert.Equal(t, "https://example.com/mailchimp/webhook", params["url"])
+		assert.Equal(t, true, params["events"].(map[string]interface{})["cleaned"])
+		assert.Equal(t, false, params["sources"].(map[string]interface{})["api"])
+
+		rw.WriteHeader(200)
+		fmt.Fprint(rw, webhookResponse)
+	})
+	defer server.Close()
+
+	webhook, err := client.CreateWebhook("list_id", &mailchimp.WebhookParams{
+		URL:     "https://example.com/mailchimp/webhook",
+		Events:  &mailchimp.WebhookEvents{Subscribe: true, Unsubscribe: true, Cleaned: true},
+		Sources: &mailchimp.WebhookSources{User: true, Admin: true},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "37b9bd8a20", webhook.ID)
+	assert.True(t, webhook.Events.Cleaned)
+	assert.False(t, webhook.Sources.API)
+}
+
+func TestListWebhooks(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "/lists/list_id/webhooks", req.URL.Path)
+
+		rw.WriteHeader(200)
+		fmt.Fprintf(rw, `{"webhooks": [%s], "list_id": "list_id", "total_items": 1}`, webhookResponse)
+	})
+	defer server.Close()
+
+	listWebhooksResponse, err := client.ListWebhooks("list_id")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, listWebhooksResponse.TotalItems)
+	assert.Equal(t, "https://example.com/mailchimp/webhook", listWebhooksResponse.Webhooks[0].URL)
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	client, server := newTestClient(t, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/lists/list_id/webhooks/37b9bd8a20", req.URL.Path)
+
+		rw.WriteHeader(204)
+	})
+	defer server.Close()
+
+	assert.NoError(t, client.DeleteWebhook("list_id", "37b9bd8a20"))
+}